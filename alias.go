@@ -0,0 +1,234 @@
+package main
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dudiko2/pokedexcli/internal/termui"
+)
+
+// maxMacroDepth bounds aliases that expand to other aliases, so an
+// alias that refers to itself fails instead of looping.
+const maxMacroDepth = 10
+
+var placeholderPattern = regexp.MustCompile(`\$([1-9]|@)`)
+
+// quoteWord quotes word so that tokenize reads it back unchanged.
+func quoteWord(word string) string {
+	if word != "" && !strings.ContainsAny(word, " \t'\"\\;") {
+		return word
+	}
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return `"` + r.Replace(word) + `"`
+}
+
+// joinWords turns the words of an alias definition back into the text
+// it stands for. A single word is taken as the whole expansion, so that
+// a quoted expansion may contain ;. Otherwise words that were quoted
+// for their spaces are quoted again.
+func joinWords(words []string) string {
+	if len(words) == 1 {
+		return words[0]
+	}
+	list := make([]string, 0, len(words))
+	for _, w := range words {
+		if w == "" || strings.ContainsAny(w, " \t'\"\\") {
+			w = quoteWord(w)
+		}
+		list = append(list, w)
+	}
+	return strings.Join(list, " ")
+}
+
+// expandMacro substitutes args for the $1 to $9 and $@ placeholders in
+// expansion. Without placeholders, args are appended to it.
+func expandMacro(expansion string, args []string) (string, error) {
+	quoted := make([]string, 0, len(args))
+	for _, a := range args {
+		quoted = append(quoted, quoteWord(a))
+	}
+	if !placeholderPattern.MatchString(expansion) {
+		return strings.Join(append([]string{expansion}, quoted...), " "), nil
+	}
+	used := 0
+	var err error
+	text := placeholderPattern.ReplaceAllStringFunc(expansion, func(p string) string {
+		if p == "$@" {
+			used = len(args)
+			return strings.Join(quoted, " ")
+		}
+		n, _ := strconv.Atoi(p[1:])
+		if n > len(args) {
+			err = fmt.Errorf("missing argument: %s", p)
+			return ""
+		}
+		used = max(used, n)
+		return quoted[n-1]
+	})
+	if err != nil {
+		return "", err
+	}
+	if used < len(args) {
+		return "", fmt.Errorf("unexpected argument: %s", args[used])
+	}
+	return text, nil
+}
+
+// runMacro runs each command an alias expands to, stopping at the first
+// one that fails.
+func runMacro(inp parsedInput, expansion string, conf *config) error {
+	if conf.macroDepth >= maxMacroDepth {
+		return fmt.Errorf("alias %s expands too deeply", inp.command)
+	}
+	text, err := expandMacro(expansion, inp.arguments)
+	if err != nil {
+		return err
+	}
+	lines, err := splitCommands(text)
+	if err != nil {
+		return err
+	}
+	conf.macroDepth++
+	defer func() { conf.macroDepth-- }()
+	for i, line := range lines {
+		p, err := parseInput(strings.TrimSpace(line))
+		if err != nil {
+			return err
+		}
+		// encoded output must stay a clean stream of documents
+		if i > 0 && p.command != "" && conf.output == outputText {
+			fmt.Println("")
+		}
+		err = execCommand(p, conf)
+		if _, isCommand := conf.commands[p.command]; isCommand && err != nil {
+			return fmt.Errorf("%s: %w", p.command, err)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func aliasNames(conf *config) []string {
+	list := make([]string, 0, len(conf.settings.Aliases))
+	for name := range conf.settings.Aliases {
+		list = append(list, name)
+	}
+	slices.Sort(list)
+	return list
+}
+
+type aliasResult struct {
+	Name      string `json:"name" yaml:"name"`
+	Expansion string `json:"expansion" yaml:"expansion"`
+}
+
+func (r aliasResult) printText(ui termui.Terminal) {
+	fmt.Printf("%s = %s", r.Name, r.Expansion)
+}
+
+type aliasListResult struct {
+	Builtin []aliasResult `json:"builtin" yaml:"builtin"`
+	Aliases []aliasResult `json:"aliases" yaml:"aliases"`
+}
+
+func (r aliasListResult) printText(ui termui.Terminal) {
+	fmt.Println("Built-in aliases:")
+	for _, a := range r.Builtin {
+		fmt.Printf("  %s = %s\n", a.Name, a.Expansion)
+	}
+	if len(r.Aliases) < 1 {
+		fmt.Print("No aliases defined, add one with: alias name = expansion")
+		return
+	}
+	fmt.Println("Aliases:")
+	for _, a := range r.Aliases {
+		fmt.Printf("  %s = %s\n", a.Name, a.Expansion)
+	}
+}
+
+func newAliasListResult(conf *config) aliasListResult {
+	r := aliasListResult{
+		Builtin: []aliasResult{},
+		Aliases: []aliasResult{},
+	}
+	for name, cmd := range conf.commands {
+		if name != cmd.name {
+			r.Builtin = append(r.Builtin, aliasResult{Name: name, Expansion: cmd.name})
+		}
+	}
+	slices.SortFunc(r.Builtin, func(a, b aliasResult) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	for _, name := range aliasNames(conf) {
+		r.Aliases = append(r.Aliases, aliasResult{Name: name, Expansion: conf.settings.Aliases[name]})
+	}
+	return r
+}
+
+func validAliasName(name string, conf *config) error {
+	if name == "" || strings.HasPrefix(name, "-") || strings.HasPrefix(name, "!") {
+		return fmt.Errorf("invalid alias name: %q", name)
+	}
+	if _, ok := conf.commands[name]; ok {
+		return fmt.Errorf("%s is already a command", name)
+	}
+	return nil
+}
+
+func runAlias(args commandArgs, conf *config) (result, error) {
+	name := args.get("name")
+	if name == "" {
+		return newAliasListResult(conf), nil
+	}
+	words := args.remaining()
+	name, first, hasEquals := strings.Cut(name, "=")
+	switch {
+	case hasEquals && first != "":
+		words = append([]string{first}, words...)
+	case hasEquals:
+	case len(words) < 1:
+		expansion, ok := conf.settings.Aliases[name]
+		if !ok {
+			return nil, fmt.Errorf("no alias named %s", name)
+		}
+		return aliasResult{Name: name, Expansion: expansion}, nil
+	case words[0] != "=":
+		return nil, errors.New("expected: alias name = expansion")
+	default:
+		words = words[1:]
+	}
+	if len(words) < 1 {
+		return nil, errors.New("missing argument: expansion")
+	}
+	err := validAliasName(name, conf)
+	if err != nil {
+		return nil, err
+	}
+	expansion := joinWords(words)
+	conf.settings.Aliases[name] = expansion
+	err = saveSettings(conf.settings)
+	if err != nil {
+		return nil, fmt.Errorf("alias added for this session only: %w", err)
+	}
+	return aliasResult{Name: name, Expansion: expansion}, nil
+}
+
+func runUnalias(args commandArgs, conf *config) (result, error) {
+	name := args.get("name")
+	if _, ok := conf.settings.Aliases[name]; !ok {
+		return nil, fmt.Errorf("no alias named %s", name)
+	}
+	delete(conf.settings.Aliases, name)
+	err := saveSettings(conf.settings)
+	if err != nil {
+		return nil, fmt.Errorf("alias removed for this session only: %w", err)
+	}
+	return messageResult{Message: "Removed alias " + name}, nil
+}
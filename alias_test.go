@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestExpandMacro(t *testing.T) {
+	cases := []struct {
+		expansion string
+		args      []string
+		expected  string
+	}{
+		{"map", nil, "map"},
+		{"catch", []string{"pikachu"}, "catch pikachu"},
+		{"catch $1; inspect $1", []string{"pikachu"}, "catch pikachu; inspect pikachu"},
+		{"catch $1; inspect $1", []string{"mr mime"}, `catch "mr mime"; inspect "mr mime"`},
+		{"breedable $2 $1", []string{"ditto", "eevee"}, "breedable eevee ditto"},
+		{"tms $@", []string{"pikachu", "red-blue"}, "tms pikachu red-blue"},
+		{"catch $1", []string{"a;b"}, `catch "a;b"`},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			got, err := expandMacro(c.expansion, c.args)
+			if err != nil {
+				t.Errorf("unexpected error for %q: %v", c.expansion, err)
+				return
+			}
+			if got != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestExpandMacroErrors(t *testing.T) {
+	cases := []struct {
+		expansion string
+		args      []string
+		expected  string
+	}{
+		{"catch $1", nil, "missing argument: $1"},
+		{"breedable $1 $2", []string{"ditto"}, "missing argument: $2"},
+		{"catch $1", []string{"pikachu", "eevee"}, "unexpected argument: eevee"},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			_, err := expandMacro(c.expansion, c.args)
+			if err == nil {
+				t.Errorf("expected an error for %q", c.expansion)
+				return
+			}
+			if err.Error() != c.expected {
+				t.Errorf("expected error %q, got %q", c.expected, err)
+			}
+		})
+	}
+}
+
+func TestJoinWords(t *testing.T) {
+	cases := []struct {
+		input    []string
+		expected string
+	}{
+		{[]string{"catch $1; inspect $1"}, "catch $1; inspect $1"},
+		{[]string{"catch", "$1;", "inspect", "$1"}, "catch $1; inspect $1"},
+		{[]string{"catch", "mr mime"}, `catch "mr mime"`},
+		{[]string{"pokedex", "--region", "kanto"}, "pokedex --region kanto"},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			got := joinWords(c.input)
+			if got != c.expected {
+				t.Errorf("expected %q for %q, got %q", c.expected, c.input, got)
+			}
+		})
+	}
+}
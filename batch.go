@@ -0,0 +1,146 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+const (
+	exitOK      = 0
+	exitFailure = 1
+	exitUsage   = 2
+)
+
+const usage = `Usage:
+  pokedexcli                      start the interactive shell
+  pokedexcli -c "cmd; cmd..."     run commands and exit
+  pokedexcli run <script>         run the commands in a script file
+  pokedexcli tui                  start the full-screen interface
+  ... | pokedexcli                run commands read from stdin
+
+Options:
+`
+
+// run dispatches on the command line and returns the exit code.
+func run(args []string) int {
+	fs := flag.NewFlagSet("pokedexcli", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), usage)
+		fs.PrintDefaults()
+	}
+	commands := fs.String("c", "", "commands to run, separated by ;")
+	keepGoing := fs.Bool("keep-going", false, "run every command even after one fails")
+	output := fs.String("output", outputText, "print results as text, json or yaml")
+	err := fs.Parse(args)
+	if errors.Is(err, flag.ErrHelp) {
+		return exitOK
+	}
+	if err != nil {
+		return exitUsage
+	}
+
+	rest := fs.Args()
+	script := ""
+	if len(rest) == 1 && rest[0] == "tui" {
+		return runTUI(newConfig())
+	}
+	if len(rest) > 0 {
+		if rest[0] != "run" || len(rest) < 2 {
+			fs.Usage()
+			return exitUsage
+		}
+		script = rest[1]
+		// allow flags after the script name too
+		if err := fs.Parse(rest[2:]); err != nil || fs.NArg() > 0 {
+			fs.Usage()
+			return exitUsage
+		}
+	}
+
+	format, err := parseOutputFormat(*output)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitUsage
+	}
+	conf := newConfig()
+	conf.output = format
+	switch {
+	case *commands != "":
+		lines, err := splitCommands(*commands)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return exitUsage
+		}
+		return runBatch(lines, conf, *keepGoing)
+	case script != "":
+		f, err := os.Open(script)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return exitUsage
+		}
+		defer f.Close()
+		return runScript(f, conf, *keepGoing)
+	case !term.IsTerminal(int(os.Stdin.Fd())):
+		return runScript(os.Stdin, conf, *keepGoing)
+	}
+	return runREPL(conf)
+}
+
+// runScript runs one command per line. Blank lines and lines starting
+// with # are skipped.
+func runScript(r io.Reader, conf *config, keepGoing bool) int {
+	lines := []string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitFailure
+	}
+	return runBatch(lines, conf, keepGoing)
+}
+
+// runBatch runs commands without prompting. It stops at the first
+// failing command unless keepGoing is set, and fails if any command
+// did.
+func runBatch(lines []string, conf *config, keepGoing bool) int {
+	code := exitOK
+	for _, line := range lines {
+		input, err := parseInput(strings.TrimSpace(line))
+		if err == nil && input.command == "" {
+			continue
+		}
+		if err == nil {
+			err = execCommand(input, conf)
+			if errors.Is(err, errExit) {
+				break
+			}
+			fmt.Println("")
+		}
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, errUnknownCommand) || input.command == "" {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		} else {
+			fmt.Fprintf(os.Stderr, "Error: %s: %v\n", input.command, err)
+		}
+		code = exitFailure
+		if !keepGoing {
+			break
+		}
+	}
+	return code
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRunBatchExit(t *testing.T) {
+	cases := []struct {
+		lines     []string
+		keepGoing bool
+		expected  int
+	}{
+		{lines: []string{"exit", "inspect missing"}, expected: exitOK},
+		{lines: []string{"inspect missing", "exit"}, keepGoing: true, expected: exitFailure},
+		{lines: []string{"format", "exit", "inspect missing"}, keepGoing: true, expected: exitOK},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			conf := &config{
+				commands: newCommands(),
+				settings: settings{Aliases: map[string]string{}},
+				output:   outputText,
+			}
+			actual := runBatch(c.lines, conf, c.keepGoing)
+			if actual != c.expected {
+				t.Errorf("expected exit code %v, got %v", c.expected, actual)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/dudiko2/pokedexcli/internal/pokeapi"
+	"github.com/dudiko2/pokedexcli/internal/termui"
+)
+
+const (
+	eggGroupDitto        = "ditto"
+	eggGroupUndiscovered = "no-eggs"
+	genderRateGenderless = -1
+	genderRateMaleOnly   = 0
+	genderRateFemaleOnly = 8
+)
+
+func hasEggGroup(s pokeapi.PokemonSpeciesRes, group string) bool {
+	return slices.ContainsFunc(s.EggGroups, func(g pokeapi.NameURLPair) bool {
+		return g.Name == group
+	})
+}
+
+// sharedEggGroups returns the egg groups a and b are both in.
+func sharedEggGroups(a, b pokeapi.PokemonSpeciesRes) []string {
+	groups := []string{}
+	for _, g := range a.EggGroups {
+		if hasEggGroup(b, g.Name) {
+			groups = append(groups, g.Name)
+		}
+	}
+	return groups
+}
+
+// eggGroupLabel looks up the name of an egg group in the current
+// language. Lookup failures fall back to the identifier.
+func eggGroupLabel(name string, conf *config) label {
+	if isDefaultLanguage(conf) {
+		return label{Name: name}
+	}
+	g, err := conf.pokeapiClient.GetEggGroup(name)
+	if err != nil {
+		return label{Name: name}
+	}
+	return textLabel(g.Names, g.Name, conf)
+}
+
+// checkBreedable reports whether two species can produce an egg, and if
+// not, why. Which parent lays the egg is decided by breedingMothers.
+func checkBreedable(a, b pokeapi.PokemonSpeciesRes) (bool, string) {
+	if hasEggGroup(a, eggGroupUndiscovered) || hasEggGroup(b, eggGroupUndiscovered) {
+		return false, "Pokemon in the Undiscovered egg group can't breed"
+	}
+	aDitto := hasEggGroup(a, eggGroupDitto)
+	bDitto := hasEggGroup(b, eggGroupDitto)
+	if aDitto && bDitto {
+		return false, "two Ditto can't breed with each other"
+	}
+	if aDitto || bDitto {
+		return true, ""
+	}
+	if a.GenderRate == genderRateGenderless || b.GenderRate == genderRateGenderless {
+		return false, "genderless Pokemon can only breed with Ditto"
+	}
+	if a.GenderRate == genderRateMaleOnly && b.GenderRate == genderRateMaleOnly {
+		return false, "both Pokemon are always male"
+	}
+	if a.GenderRate == genderRateFemaleOnly && b.GenderRate == genderRateFemaleOnly {
+		return false, "both Pokemon are always female"
+	}
+	if len(sharedEggGroups(a, b)) < 1 {
+		return false, "they don't share an egg group"
+	}
+	return true, ""
+}
+
+// breedingMothers returns the parents that could lay the egg. With Ditto
+// the other parent always does; otherwise any parent that can be female.
+func breedingMothers(a, b pokeapi.PokemonSpeciesRes) []pokeapi.PokemonSpeciesRes {
+	if hasEggGroup(a, eggGroupDitto) {
+		return []pokeapi.PokemonSpeciesRes{b}
+	}
+	if hasEggGroup(b, eggGroupDitto) {
+		return []pokeapi.PokemonSpeciesRes{a}
+	}
+	mothers := []pokeapi.PokemonSpeciesRes{}
+	for _, s := range []pokeapi.PokemonSpeciesRes{a, b} {
+		if s.GenderRate > genderRateMaleOnly {
+			mothers = append(mothers, s)
+		}
+	}
+	return mothers
+}
+
+// baseSpecies follows evolves_from_species back to the first stage,
+// which is what hatches from an egg.
+func baseSpecies(s pokeapi.PokemonSpeciesRes, conf *config) (pokeapi.PokemonSpeciesRes, error) {
+	ctx := context.Background()
+	for s.EvolvesFromSpecies != nil {
+		prev, err := pokeapi.Resolve[pokeapi.PokemonSpeciesRes](ctx, &conf.pokeapiClient, *s.EvolvesFromSpecies)
+		if err != nil {
+			return pokeapi.PokemonSpeciesRes{}, err
+		}
+		s = prev
+	}
+	return s, nil
+}
+
+func getCaughtSpecies(name string, conf *config) (ownedPokemon, pokeapi.PokemonSpeciesRes, error) {
+	p, err := findCaught(name)
+	if err != nil {
+		return ownedPokemon{}, pokeapi.PokemonSpeciesRes{}, err
+	}
+	s, err := conf.pokeapiClient.GetPokemonSpecies(p.Species.Name)
+	return p, s, err
+}
+
+type offspringResult struct {
+	Species   label `json:"species" yaml:"species"`
+	EggCycles int   `json:"egg_cycles" yaml:"egg_cycles"`
+}
+
+type breedableResult struct {
+	First     label             `json:"first" yaml:"first"`
+	Second    label             `json:"second" yaml:"second"`
+	Breedable bool              `json:"breedable" yaml:"breedable"`
+	Reason    string            `json:"reason,omitempty" yaml:"reason,omitempty"`
+	EggGroups []label           `json:"egg_groups,omitempty" yaml:"egg_groups,omitempty"`
+	Offspring []offspringResult `json:"offspring,omitempty" yaml:"offspring,omitempty"`
+}
+
+func (r breedableResult) printText(ui termui.Terminal) {
+	if !r.Breedable {
+		fmt.Printf("%s and %s %s: %s\n", r.First, r.Second, failure(ui, "can't breed"), r.Reason)
+		return
+	}
+	fmt.Printf("%s and %s %s\n", r.First, r.Second, success(ui, "can breed!"))
+	names := []string{}
+	for _, g := range r.EggGroups {
+		names = append(names, g.String())
+	}
+	if len(names) > 0 {
+		fmt.Printf("Shared egg groups: %s\n", strings.Join(names, ", "))
+	}
+	names = []string{}
+	for _, o := range r.Offspring {
+		fmt.Printf("- offspring: %s (hatches after %v egg cycles)\n", o.Species, o.EggCycles)
+		names = append(names, o.Species.String())
+	}
+	if len(names) > 1 {
+		fmt.Printf("The species depends on which parent is female (%s)\n", strings.Join(names, " or "))
+	}
+}
+
+func runBreedable(args commandArgs, conf *config) (result, error) {
+	pa, a, err := getCaughtSpecies(args.get("first"), conf)
+	if err != nil {
+		return nil, err
+	}
+	pb, b, err := getCaughtSpecies(args.get("second"), conf)
+	if err != nil {
+		return nil, err
+	}
+	r := breedableResult{
+		First:  pokemonLabel(pa.Name, conf),
+		Second: pokemonLabel(pb.Name, conf),
+	}
+	r.Breedable, r.Reason = checkBreedable(a, b)
+	if !r.Breedable {
+		return r, nil
+	}
+	for _, g := range sharedEggGroups(a, b) {
+		r.EggGroups = append(r.EggGroups, eggGroupLabel(g, conf))
+	}
+	for _, mother := range breedingMothers(a, b) {
+		base, err := baseSpecies(mother, conf)
+		if err != nil {
+			return nil, err
+		}
+		seen := slices.ContainsFunc(r.Offspring, func(o offspringResult) bool {
+			return o.Species.Name == base.Name
+		})
+		if !seen {
+			r.Offspring = append(r.Offspring, offspringResult{
+				Species:   textLabel(base.Names, base.Name, conf),
+				EggCycles: base.HatchCounter,
+			})
+		}
+	}
+	return r, nil
+}
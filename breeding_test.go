@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dudiko2/pokedexcli/internal/pokeapi"
+)
+
+func testSpecies(name string, genderRate int, eggGroups ...string) pokeapi.PokemonSpeciesRes {
+	s := pokeapi.PokemonSpeciesRes{Name: name, GenderRate: genderRate}
+	for _, g := range eggGroups {
+		s.EggGroups = append(s.EggGroups, pokeapi.NameURLPair{Name: g})
+	}
+	return s
+}
+
+func TestCheckBreedable(t *testing.T) {
+	pikachu := testSpecies("pikachu", 4, "ground", "fairy")
+	clefairy := testSpecies("clefairy", 6, "fairy")
+	ditto := testSpecies("ditto", genderRateGenderless, eggGroupDitto)
+	magnemite := testSpecies("magnemite", genderRateGenderless, "mineral")
+	mewtwo := testSpecies("mewtwo", genderRateGenderless, eggGroupUndiscovered)
+	nidoking := testSpecies("nidoking", genderRateMaleOnly, "monster", "ground")
+	tauros := testSpecies("tauros", genderRateMaleOnly, "ground")
+	chansey := testSpecies("chansey", genderRateFemaleOnly, "fairy")
+	kangaskhan := testSpecies("kangaskhan", genderRateFemaleOnly, "monster")
+	squirtle := testSpecies("squirtle", 1, "monster", "water1")
+	cases := []struct {
+		a, b     pokeapi.PokemonSpeciesRes
+		expected bool
+	}{
+		{a: pikachu, b: clefairy, expected: true},
+		{a: pikachu, b: squirtle, expected: false},
+		{a: pikachu, b: ditto, expected: true},
+		{a: magnemite, b: ditto, expected: true},
+		{a: ditto, b: ditto, expected: false},
+		{a: mewtwo, b: ditto, expected: false},
+		{a: magnemite, b: pikachu, expected: false},
+		{a: nidoking, b: tauros, expected: false},
+		{a: chansey, b: clefairy, expected: true},
+		{a: chansey, b: kangaskhan, expected: false},
+		{a: nidoking, b: kangaskhan, expected: true},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			actual, reason := checkBreedable(c.a, c.b)
+			if actual != c.expected {
+				t.Errorf("expected %v for %s and %s, got %v (%s)", c.expected, c.a.Name, c.b.Name, actual, reason)
+				return
+			}
+			if !actual && reason == "" {
+				t.Errorf("expected a reason for %s and %s", c.a.Name, c.b.Name)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// cliArg is a positional argument. Optional arguments must come after
+// the required ones, and only the last one may be variadic.
+type cliArg struct {
+	name        string
+	description string
+	optional    bool
+	// variadic takes every remaining word, flags included, as typed.
+	variadic bool
+}
+
+// cliFlag is a --flag. A flag with a value placeholder takes a value,
+// as --flag <value> or --flag=<value>, otherwise it is a switch.
+type cliFlag struct {
+	name        string
+	value       string
+	description string
+	// optionalValue lets the flag be given without its value, which is
+	// then left empty for the command to fill in.
+	optionalValue bool
+}
+
+// commandArgs holds the arguments and flags given to a command, keyed
+// by their declared names.
+type commandArgs struct {
+	positional map[string]string
+	flags      map[string]string
+	rest       []string
+}
+
+// get returns a positional argument, or "" if it wasn't given.
+func (a commandArgs) get(name string) string {
+	return a.positional[name]
+}
+
+// remaining returns the words taken by a variadic argument.
+func (a commandArgs) remaining() []string {
+	return a.rest
+}
+
+// flag returns a flag's value and whether the flag was given at all.
+func (a commandArgs) flag(name string) (string, bool) {
+	value, ok := a.flags[name]
+	return value, ok
+}
+
+// has reports whether a switch or flag was given.
+func (a commandArgs) has(name string) bool {
+	_, ok := a.flags[name]
+	return ok
+}
+
+func (cmd cliCommand) findFlag(name string) (cliFlag, bool) {
+	for _, f := range cmd.flags {
+		if f.name == name {
+			return f, true
+		}
+	}
+	return cliFlag{}, false
+}
+
+func (cmd cliCommand) findSubcommand(name string) (cliCommand, bool) {
+	for _, sub := range cmd.subcommands {
+		if sub.name == name {
+			return sub, true
+		}
+	}
+	return cliCommand{}, false
+}
+
+func (cmd cliCommand) subcommandNames() []string {
+	list := make([]string, 0, len(cmd.subcommands))
+	for _, sub := range cmd.subcommands {
+		list = append(list, sub.name)
+	}
+	return list
+}
+
+// resolve follows words into cmd's subcommands, returning the command
+// that will run and the words left for it.
+func (cmd cliCommand) resolve(words []string) (cliCommand, []string, error) {
+	for len(cmd.subcommands) > 0 {
+		if len(words) < 1 || strings.HasPrefix(words[0], "--") {
+			return cliCommand{}, nil, fmt.Errorf("missing subcommand: %s", strings.Join(cmd.subcommandNames(), " or "))
+		}
+		sub, ok := cmd.findSubcommand(words[0])
+		if !ok {
+			return cliCommand{}, nil, fmt.Errorf("unknown %s subcommand: %s%s", cmd.name, words[0], didYouMean(words[0], cmd.subcommandNames()))
+		}
+		cmd = sub
+		words = words[1:]
+	}
+	return cmd, words, nil
+}
+
+// parseArgs matches words against cmd's declared flags and positional
+// arguments. Everything after a bare -- is positional.
+func (cmd cliCommand) parseArgs(words []string) (commandArgs, error) {
+	args := commandArgs{
+		positional: map[string]string{},
+		flags:      map[string]string{},
+	}
+	variadicAt := -1
+	if n := len(cmd.args); n > 0 && cmd.args[n-1].variadic {
+		variadicAt = n - 1
+	}
+	values := []string{}
+	for i := 0; i < len(words); i++ {
+		word := words[i]
+		if variadicAt >= 0 && len(values) >= variadicAt {
+			values = append(values, words[i:]...)
+			break
+		}
+		if word == "--" {
+			values = append(values, words[i+1:]...)
+			break
+		}
+		name, ok := strings.CutPrefix(word, "--")
+		if !ok || name == "" {
+			values = append(values, word)
+			continue
+		}
+		name, value, hasValue := strings.Cut(name, "=")
+		f, ok := cmd.findFlag(name)
+		if !ok {
+			return commandArgs{}, fmt.Errorf("unknown flag: --%s", name)
+		}
+		if _, ok := args.flags[name]; ok {
+			return commandArgs{}, fmt.Errorf("flag given twice: --%s", name)
+		}
+		switch {
+		case f.value == "" && hasValue:
+			return commandArgs{}, fmt.Errorf("flag --%s does not take a value", name)
+		case f.value == "" || hasValue:
+		case i+1 < len(words) && !strings.HasPrefix(words[i+1], "--"):
+			i++
+			value = words[i]
+		case !f.optionalValue:
+			return commandArgs{}, fmt.Errorf("missing value for flag: --%s", name)
+		}
+		args.flags[name] = value
+	}
+	if variadicAt >= 0 && len(values) > variadicAt {
+		args.rest = values[variadicAt:]
+		values = values[:variadicAt+1]
+	}
+	for i, arg := range cmd.args {
+		if i < len(values) {
+			args.positional[arg.name] = values[i]
+			continue
+		}
+		if !arg.optional {
+			return commandArgs{}, fmt.Errorf("missing argument: %s", arg.name)
+		}
+	}
+	if len(values) > len(cmd.args) {
+		return commandArgs{}, fmt.Errorf("unexpected argument: %s", values[len(cmd.args)])
+	}
+	return args, nil
+}
+
+// usage is a one line synopsis built from cmd's declarations, e.g.
+// "pokedex [--region [<name>]]".
+func (cmd cliCommand) usage() string {
+	parts := []string{cmd.name}
+	for _, a := range cmd.args {
+		name := a.name
+		if a.variadic {
+			name += "..."
+		}
+		if a.optional {
+			parts = append(parts, "["+name+"]")
+		} else {
+			parts = append(parts, "<"+name+">")
+		}
+	}
+	for _, f := range cmd.flags {
+		switch {
+		case f.value == "":
+			parts = append(parts, "[--"+f.name+"]")
+		case f.optionalValue:
+			parts = append(parts, fmt.Sprintf("[--%s [<%s>]]", f.name, f.value))
+		default:
+			parts = append(parts, fmt.Sprintf("[--%s <%s>]", f.name, f.value))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// callCommand resolves subcommands, validates words against the
+// declarations and runs the command.
+func callCommand(cmd cliCommand, words []string, conf *config) (result, error) {
+	cmd, words, err := cmd.resolve(words)
+	if err != nil {
+		return nil, err
+	}
+	args, err := cmd.parseArgs(words)
+	if err != nil {
+		return nil, err
+	}
+	return cmd.callback(args, conf)
+}
+
+// runCommand runs a command and prints its result.
+func runCommand(cmd cliCommand, words []string, conf *config) error {
+	res, err := callCommand(cmd, words, conf)
+	if err != nil {
+		return err
+	}
+	return render(res, conf)
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+)
+
+func testCommand() cliCommand {
+	return cliCommand{
+		name: "test",
+		args: []cliArg{
+			{name: "id"},
+			{name: "version", optional: true},
+		},
+		flags: []cliFlag{
+			{name: "all"},
+			{name: "range", value: "from-to"},
+			{name: "region", value: "name", optionalValue: true},
+		},
+	}
+}
+
+func TestParseArgs(t *testing.T) {
+	cases := []struct {
+		input      []string
+		positional map[string]string
+		flags      map[string]string
+	}{
+		{
+			input:      []string{"pikachu"},
+			positional: map[string]string{"id": "pikachu"},
+			flags:      map[string]string{},
+		},
+		{
+			input:      []string{"pikachu", "red", "--all"},
+			positional: map[string]string{"id": "pikachu", "version": "red"},
+			flags:      map[string]string{"all": ""},
+		},
+		{
+			input:      []string{"--range", "1-151", "pikachu"},
+			positional: map[string]string{"id": "pikachu"},
+			flags:      map[string]string{"range": "1-151"},
+		},
+		{
+			input:      []string{"pikachu", "--range=1-151"},
+			positional: map[string]string{"id": "pikachu"},
+			flags:      map[string]string{"range": "1-151"},
+		},
+		{
+			input:      []string{"pikachu", "--region"},
+			positional: map[string]string{"id": "pikachu"},
+			flags:      map[string]string{"region": ""},
+		},
+		{
+			input:      []string{"pikachu", "--region", "--all"},
+			positional: map[string]string{"id": "pikachu"},
+			flags:      map[string]string{"region": "", "all": ""},
+		},
+		{
+			input:      []string{"--", "--all"},
+			positional: map[string]string{"id": "--all"},
+			flags:      map[string]string{},
+		},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			got, err := testCommand().parseArgs(c.input)
+			if err != nil {
+				t.Errorf("unexpected error for %q: %v", c.input, err)
+				return
+			}
+			if !maps.Equal(got.positional, c.positional) {
+				t.Errorf("expected arguments %v for %q, got %v", c.positional, c.input, got.positional)
+			}
+			if !maps.Equal(got.flags, c.flags) {
+				t.Errorf("expected flags %v for %q, got %v", c.flags, c.input, got.flags)
+			}
+		})
+	}
+}
+
+func TestParseArgsErrors(t *testing.T) {
+	cases := []struct {
+		input    []string
+		expected string
+	}{
+		{[]string{}, "missing argument: id"},
+		{[]string{"--all"}, "missing argument: id"},
+		{[]string{"a", "b", "c"}, "unexpected argument: c"},
+		{[]string{"a", "--shiny"}, "unknown flag: --shiny"},
+		{[]string{"a", "--range"}, "missing value for flag: --range"},
+		{[]string{"a", "--all=yes"}, "flag --all does not take a value"},
+		{[]string{"a", "--all", "--all"}, "flag given twice: --all"},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			_, err := testCommand().parseArgs(c.input)
+			if err == nil {
+				t.Errorf("expected an error for %q", c.input)
+				return
+			}
+			if err.Error() != c.expected {
+				t.Errorf("expected error %q for %q, got %q", c.expected, c.input, err)
+			}
+		})
+	}
+}
+
+func TestResolveSubcommand(t *testing.T) {
+	cmd := newCommands()["snapshot"]
+	sub, rest, err := cmd.resolve([]string{"export", "out.tar.gz"})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if sub.name != "export" || len(rest) != 1 || rest[0] != "out.tar.gz" {
+		t.Errorf("expected export with [out.tar.gz], got %s with %q", sub.name, rest)
+		return
+	}
+	_, _, err = cmd.resolve(nil)
+	if err == nil || err.Error() != "missing subcommand: export or import" {
+		t.Errorf("unexpected error for no subcommand: %v", err)
+		return
+	}
+	_, _, err = cmd.resolve([]string{"delete"})
+	if err == nil || err.Error() != "unknown snapshot subcommand: delete" {
+		t.Errorf("unexpected error for unknown subcommand: %v", err)
+	}
+}
+
+func TestUsage(t *testing.T) {
+	expected := "test <id> [version] [--all] [--range <from-to>] [--region [<name>]]"
+	got := testCommand().usage()
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestCommandsHaveCategory(t *testing.T) {
+	for name, cmd := range newCommands() {
+		if !slices.Contains(commandCategories, cmd.category) {
+			t.Errorf("command %s has unknown category %q", name, cmd.category)
+		}
+		if cmd.name != name && !slices.Contains(cmd.aliases, name) {
+			t.Errorf("command %s is registered as %s", cmd.name, name)
+		}
+	}
+}
+
+func TestParseArgsVariadic(t *testing.T) {
+	cmd := newCommands()["alias"]
+	got, err := cmd.parseArgs([]string{"kd", "=", "pokedex", "--region", "kanto"})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	expected := []string{"=", "pokedex", "--region", "kanto"}
+	if got.get("name") != "kd" || !slices.Equal(got.remaining(), expected) {
+		t.Errorf("expected kd with %q, got %s with %q", expected, got.get("name"), got.remaining())
+	}
+}
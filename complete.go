@@ -0,0 +1,98 @@
+package main
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/dudiko2/pokedexcli/internal/pokeapi"
+)
+
+func names(refs []pokeapi.NameURLPair) []string {
+	list := make([]string, 0, len(refs))
+	for _, r := range refs {
+		list = append(list, r.Name)
+	}
+	return list
+}
+
+func commandNames(conf *config) []string {
+	list := make([]string, 0, len(conf.commands))
+	for name := range conf.commands {
+		list = append(list, name)
+	}
+	for name := range conf.settings.Aliases {
+		list = append(list, name)
+	}
+	return list
+}
+
+func caughtNames() []string {
+	list := make([]string, 0, len(caughtPokemon))
+	for name := range caughtPokemon {
+		list = append(list, name)
+	}
+	return list
+}
+
+// argumentCandidates lists what may follow command as its first
+// argument, based on what the session has shown so far.
+func argumentCandidates(command string, conf *config) []string {
+	if cmd, ok := conf.commands[command]; ok {
+		command = cmd.name
+	}
+	switch command {
+	case "inspect", "breedable":
+		return caughtNames()
+	case "explore":
+		return conf.lastAreas
+	case "catch":
+		return conf.lastPokemon
+	}
+	return conf.commands[command].subcommandNames()
+}
+
+// flagCandidates lists the flags of the command that words resolve to.
+func flagCandidates(words []string, conf *config) []string {
+	cmd, ok := conf.commands[words[0]]
+	if !ok {
+		return nil
+	}
+	cmd, _, err := cmd.resolve(words[1:])
+	if err != nil {
+		return nil
+	}
+	list := make([]string, 0, len(cmd.flags))
+	for _, f := range cmd.flags {
+		list = append(list, "--"+f.name)
+	}
+	return list
+}
+
+// completeInput returns the completions for the last word of before,
+// the input up to the cursor.
+func completeInput(before string, conf *config) []string {
+	words := strings.Fields(before)
+	typingNewWord := before == "" || strings.HasSuffix(before, " ")
+	current := ""
+	if !typingNewWord {
+		current = words[len(words)-1]
+		words = words[:len(words)-1]
+	}
+	var candidates []string
+	switch {
+	case len(words) == 0:
+		candidates = commandNames(conf)
+	case strings.HasPrefix(current, "-"):
+		candidates = flagCandidates(words, conf)
+	case len(words) == 1 || words[0] == "breedable" && len(words) == 2:
+		candidates = argumentCandidates(words[0], conf)
+	}
+	matches := []string{}
+	for _, c := range candidates {
+		if strings.HasPrefix(c, current) && !slices.Contains(matches, c) {
+			matches = append(matches, c)
+		}
+	}
+	slices.Sort(matches)
+	return matches
+}
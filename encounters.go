@@ -0,0 +1,158 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dudiko2/pokedexcli/internal/pokeapi"
+	"github.com/dudiko2/pokedexcli/internal/termui"
+)
+
+type encounterSummary struct {
+	area       pokeapi.NameURLPair
+	method     pokeapi.NameURLPair
+	conditions []pokeapi.NameURLPair
+	minLevel   int
+	maxLevel   int
+	chance     int
+}
+
+type versionEncounters struct {
+	version    pokeapi.NameURLPair
+	encounters []*encounterSummary
+}
+
+// groupEncountersByVersion merges the individual encounter slots of each
+// area into one entry per method and set of conditions, keeping versions
+// in API order. Slot chances only add up within the same conditions: a
+// morning and a night slot are different encounters.
+func groupEncountersByVersion(res []pokeapi.PokemonEncounterRes) []versionEncounters {
+	groups := []versionEncounters{}
+	versionIndex := map[string]int{}
+	for _, areaEnc := range res {
+		for _, vd := range areaEnc.VersionDetails {
+			i, ok := versionIndex[vd.Version.Name]
+			if !ok {
+				i = len(groups)
+				versionIndex[vd.Version.Name] = i
+				groups = append(groups, versionEncounters{version: vd.Version})
+			}
+			byMethod := map[string]*encounterSummary{}
+			for _, d := range vd.EncounterDetails {
+				key := d.Method.Name
+				for _, c := range d.ConditionValues {
+					key += " " + c.Name
+				}
+				s, ok := byMethod[key]
+				if !ok {
+					s = &encounterSummary{
+						area:       areaEnc.LocationArea,
+						method:     d.Method,
+						conditions: d.ConditionValues,
+						minLevel:   d.MinLevel,
+						maxLevel:   d.MaxLevel,
+					}
+					byMethod[key] = s
+					groups[i].encounters = append(groups[i].encounters, s)
+				}
+				s.minLevel = min(s.minLevel, d.MinLevel)
+				s.maxLevel = max(s.maxLevel, d.MaxLevel)
+				s.chance += d.Chance
+			}
+		}
+	}
+	return groups
+}
+
+func formatLevelRange(minLevel, maxLevel int) string {
+	if minLevel == maxLevel {
+		return fmt.Sprintf("lv %v", minLevel)
+	}
+	return fmt.Sprintf("lv %v-%v", minLevel, maxLevel)
+}
+
+type encounterResult struct {
+	Area       label   `json:"area" yaml:"area"`
+	Method     label   `json:"method" yaml:"method"`
+	Conditions []label `json:"conditions,omitempty" yaml:"conditions,omitempty"`
+	MinLevel   int     `json:"min_level" yaml:"min_level"`
+	MaxLevel   int     `json:"max_level" yaml:"max_level"`
+	Chance     int     `json:"chance" yaml:"chance"`
+}
+
+// method shows the method with the conditions it needs, e.g.
+// "walk (time-night)".
+func (e encounterResult) method() string {
+	if len(e.Conditions) < 1 {
+		return e.Method.String()
+	}
+	conditions := make([]string, 0, len(e.Conditions))
+	for _, c := range e.Conditions {
+		conditions = append(conditions, c.String())
+	}
+	return fmt.Sprintf("%s (%s)", e.Method, strings.Join(conditions, ", "))
+}
+
+type versionEncounterResult struct {
+	Version    label             `json:"version" yaml:"version"`
+	Encounters []encounterResult `json:"encounters" yaml:"encounters"`
+}
+
+type whereResult struct {
+	Pokemon  label                    `json:"pokemon" yaml:"pokemon"`
+	Versions []versionEncounterResult `json:"versions" yaml:"versions"`
+}
+
+func (r whereResult) printText(ui termui.Terminal) {
+	if len(r.Versions) < 1 {
+		fmt.Printf("%s can't be found in the wild\n", r.Pokemon)
+		return
+	}
+	fmt.Printf("%s can be found in:\n", r.Pokemon)
+	tab := termui.Table{
+		Header: []string{"Version", "Area", "Method", "Levels", "Chance"},
+		Right:  []int{4},
+	}
+	for _, v := range r.Versions {
+		for i, e := range v.Encounters {
+			version := ""
+			if i == 0 {
+				version = heading(ui, v.Version.String())
+			}
+			levels := formatLevelRange(e.MinLevel, e.MaxLevel)
+			chance := fmt.Sprintf("%v%%", e.Chance)
+			tab.Rows = append(tab.Rows, []string{version, e.Area.String(), e.method(), levels, chance})
+		}
+	}
+	fmt.Print(ui.Render(tab))
+}
+
+func runWhere(args commandArgs, conf *config) (result, error) {
+	pokemon, err := lookup("pokemon", args.get("id"), conf, conf.pokeapiClient.GetPokemonData)
+	if err != nil {
+		return nil, err
+	}
+	res, err := conf.pokeapiClient.GetPokemonEncounters(pokemon.Name)
+	if err != nil {
+		return nil, err
+	}
+	r := whereResult{
+		Pokemon:  pokemonLabel(pokemon.Name, conf),
+		Versions: []versionEncounterResult{},
+	}
+	for _, g := range groupEncountersByVersion(res) {
+		v := versionEncounterResult{Version: refLabel(g.version, conf)}
+		for _, e := range g.encounters {
+			v.Encounters = append(v.Encounters, encounterResult{
+				Area:       refLabel(e.area, conf),
+				Method:     refLabel(e.method, conf),
+				Conditions: refLabels(e.conditions, conf),
+				MinLevel:   e.minLevel,
+				MaxLevel:   e.maxLevel,
+				Chance:     e.chance,
+			})
+		}
+		r.Versions = append(r.Versions, v)
+	}
+	return r, nil
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/dudiko2/pokedexcli/internal/pokeapi"
+)
+
+const encountersFixture = `[
+  {
+    "location_area": {"name": "viridian-forest-area", "url": ""},
+    "version_details": [
+      {
+        "version": {"name": "red", "url": ""},
+        "max_chance": 10,
+        "encounter_details": [
+          {"chance": 5, "min_level": 3, "max_level": 3, "method": {"name": "walk", "url": ""}, "condition_values": []},
+          {"chance": 5, "min_level": 5, "max_level": 5, "method": {"name": "walk", "url": ""}, "condition_values": []}
+        ]
+      },
+      {
+        "version": {"name": "gold", "url": ""},
+        "max_chance": 60,
+        "encounter_details": [
+          {"chance": 60, "min_level": 4, "max_level": 4, "method": {"name": "walk", "url": ""}, "condition_values": [{"name": "time-morning", "url": ""}]},
+          {"chance": 60, "min_level": 6, "max_level": 6, "method": {"name": "walk", "url": ""}, "condition_values": [{"name": "time-night", "url": ""}]},
+          {"chance": 40, "min_level": 8, "max_level": 8, "method": {"name": "surf", "url": ""}, "condition_values": []}
+        ]
+      }
+    ]
+  }
+]`
+
+func TestGroupEncountersByVersion(t *testing.T) {
+	var res []pokeapi.PokemonEncounterRes
+	if err := json.Unmarshal([]byte(encountersFixture), &res); err != nil {
+		t.Fatal(err)
+	}
+	groups := groupEncountersByVersion(res)
+	cases := []struct {
+		version    int
+		encounter  int
+		method     string
+		conditions int
+		minLevel   int
+		maxLevel   int
+		chance     int
+	}{
+		{version: 0, encounter: 0, method: "walk", conditions: 0, minLevel: 3, maxLevel: 5, chance: 10},
+		{version: 1, encounter: 0, method: "walk", conditions: 1, minLevel: 4, maxLevel: 4, chance: 60},
+		{version: 1, encounter: 1, method: "walk", conditions: 1, minLevel: 6, maxLevel: 6, chance: 60},
+		{version: 1, encounter: 2, method: "surf", conditions: 0, minLevel: 8, maxLevel: 8, chance: 40},
+	}
+	if len(groups) != 2 || len(groups[0].encounters) != 1 || len(groups[1].encounters) != 3 {
+		t.Fatalf("unexpected grouping: %+v", groups)
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			s := groups[c.version].encounters[c.encounter]
+			if s.method.Name != c.method || len(s.conditions) != c.conditions {
+				t.Errorf("expected %s with %v conditions, got %s with %v", c.method, c.conditions, s.method.Name, len(s.conditions))
+				return
+			}
+			if s.minLevel != c.minLevel || s.maxLevel != c.maxLevel || s.chance != c.chance {
+				t.Errorf("expected lv %v-%v %v%%, got lv %v-%v %v%%", c.minLevel, c.maxLevel, c.chance, s.minLevel, s.maxLevel, s.chance)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"math/rand"
+
+	"github.com/dudiko2/pokedexcli/internal/pokeapi"
+)
+
+const (
+	minWildLevel     = 2
+	maxWildLevel     = 20
+	progressBarWidth = 20
+)
+
+func randomWildLevel() int {
+	return minWildLevel + rand.Intn(maxWildLevel-minWildLevel+1)
+}
+
+// experienceForLevel returns the total experience needed to reach level
+// on the given curve.
+func experienceForLevel(rate pokeapi.GrowthRateRes, level int) int {
+	for _, l := range rate.Levels {
+		if l.Level == level {
+			return l.Experience
+		}
+	}
+	return 0
+}
+
+// levelForExperience returns the highest level whose experience
+// requirement has been met.
+func levelForExperience(rate pokeapi.GrowthRateRes, experience int) int {
+	level := 1
+	for _, l := range rate.Levels {
+		if l.Experience <= experience && l.Level > level {
+			level = l.Level
+		}
+	}
+	return level
+}
+
+func maxLevel(rate pokeapi.GrowthRateRes) int {
+	level := 1
+	for _, l := range rate.Levels {
+		level = max(level, l.Level)
+	}
+	return level
+}
+
+func (p ownedPokemon) level() int {
+	return levelForExperience(p.growthRate, p.experience)
+}
+
+// experienceYield is the experience shared out when a Pokemon of the
+// given level is caught, following the classic base_experience * L / 7.
+func experienceYield(p pokeapi.PokemonRes, level int) int {
+	return p.BaseExperience * level / 7
+}
+
+type levelUp struct {
+	Pokemon label `json:"pokemon" yaml:"pokemon"`
+	Level   int   `json:"level" yaml:"level"`
+}
+
+// awardExperience gives every caught Pokemon the same amount of
+// experience and returns any level ups.
+func awardExperience(amount int, conf *config) []levelUp {
+	if amount < 1 {
+		return nil
+	}
+	levelUps := []levelUp{}
+	for name, p := range caughtPokemon {
+		before := p.level()
+		p.experience += amount
+		caughtPokemon[name] = p
+		if after := p.level(); after > before {
+			levelUps = append(levelUps, levelUp{Pokemon: pokemonLabel(name, conf), Level: after})
+		}
+	}
+	return levelUps
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/dudiko2/pokedexcli/internal/pokeapi"
+)
+
+// testGrowthRate is the start of the medium-fast curve, experience = n^3.
+var testGrowthRate = pokeapi.GrowthRateRes{
+	Name: "medium",
+	Levels: []pokeapi.GrowthRateLevel{
+		{Level: 1, Experience: 0},
+		{Level: 2, Experience: 8},
+		{Level: 3, Experience: 27},
+		{Level: 4, Experience: 64},
+		{Level: 5, Experience: 125},
+	},
+}
+
+func TestExperienceForLevel(t *testing.T) {
+	cases := []struct {
+		level    int
+		expected int
+	}{
+		{level: 1, expected: 0},
+		{level: 3, expected: 27},
+		{level: 5, expected: 125},
+		{level: 6, expected: 0},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			actual := experienceForLevel(testGrowthRate, c.level)
+			if actual != c.expected {
+				t.Errorf("expected %v, got %v", c.expected, actual)
+				return
+			}
+		})
+	}
+}
+
+func TestLevelForExperience(t *testing.T) {
+	cases := []struct {
+		experience int
+		expected   int
+	}{
+		{experience: 0, expected: 1},
+		{experience: 7, expected: 1},
+		{experience: 8, expected: 2},
+		{experience: 63, expected: 3},
+		{experience: 64, expected: 4},
+		{experience: 1000, expected: 5},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			actual := levelForExperience(testGrowthRate, c.experience)
+			if actual != c.expected {
+				t.Errorf("expected %v, got %v", c.expected, actual)
+				return
+			}
+		})
+	}
+}
+
+func TestAwardExperience(t *testing.T) {
+	saved := caughtPokemon
+	t.Cleanup(func() {
+		caughtPokemon = saved
+	})
+	conf := &config{language: pokeapi.DefaultLanguage}
+	cases := []struct {
+		experience int
+		amount     int
+		expected   []levelUp
+		level      int
+	}{
+		{experience: 0, amount: 7, expected: []levelUp{}, level: 1},
+		{experience: 7, amount: 1, expected: []levelUp{{Pokemon: label{Name: "pidgey"}, Level: 2}}, level: 2},
+		{experience: 20, amount: 50, expected: []levelUp{{Pokemon: label{Name: "pidgey"}, Level: 4}}, level: 4},
+		{experience: 125, amount: 10, expected: []levelUp{}, level: 5},
+		{experience: 7, amount: 0, expected: nil, level: 1},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			p := ownedPokemon{growthRate: testGrowthRate, experience: c.experience}
+			p.Name = "pidgey"
+			caughtPokemon = map[string]ownedPokemon{"pidgey": p}
+			actual := awardExperience(c.amount, conf)
+			if !slices.Equal(actual, c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, actual)
+				return
+			}
+			if level := caughtPokemon["pidgey"].level(); level != c.level {
+				t.Errorf("expected level %v, got %v", c.level, level)
+				return
+			}
+		})
+	}
+}
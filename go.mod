@@ -1,3 +1,10 @@
 module github.com/dudiko2/pokedexcli
 
 go 1.22.1
+
+require (
+	golang.org/x/term v0.22.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require golang.org/x/sys v0.22.0 // indirect
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/dudiko2/pokedexcli/internal/termui"
+)
+
+const (
+	categoryNavigation = "Navigation"
+	categoryCollection = "Collection"
+	categoryInfo       = "Info"
+	categorySystem     = "System"
+)
+
+// commandCategories is the order help lists the categories in.
+var commandCategories = []string{
+	categoryNavigation,
+	categoryCollection,
+	categoryInfo,
+	categorySystem,
+}
+
+// sortedCommands returns the commands in category, sorted by name.
+func sortedCommands(category string, conf *config) []cliCommand {
+	list := []cliCommand{}
+	for name, cmd := range conf.commands {
+		if cmd.category == category && name == cmd.name {
+			list = append(list, cmd)
+		}
+	}
+	slices.SortFunc(list, func(a, b cliCommand) int {
+		return cmp.Compare(a.name, b.name)
+	})
+	return list
+}
+
+type argHelp struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description" yaml:"description"`
+	Optional    bool   `json:"optional" yaml:"optional"`
+}
+
+type flagHelp struct {
+	Name        string `json:"name" yaml:"name"`
+	Value       string `json:"value,omitempty" yaml:"value,omitempty"`
+	Description string `json:"description" yaml:"description"`
+}
+
+// commandHelp describes a command as declared in newCommands.
+type commandHelp struct {
+	Name        string        `json:"name" yaml:"name"`
+	Usage       string        `json:"usage" yaml:"usage"`
+	Description string        `json:"description" yaml:"description"`
+	Aliases     []string      `json:"aliases,omitempty" yaml:"aliases,omitempty"`
+	Arguments   []argHelp     `json:"arguments,omitempty" yaml:"arguments,omitempty"`
+	Flags       []flagHelp    `json:"flags,omitempty" yaml:"flags,omitempty"`
+	Subcommands []commandHelp `json:"subcommands,omitempty" yaml:"subcommands,omitempty"`
+	Examples    []string      `json:"examples,omitempty" yaml:"examples,omitempty"`
+}
+
+func newCommandHelp(cmd cliCommand) commandHelp {
+	h := commandHelp{
+		Name:        cmd.name,
+		Usage:       cmd.usage(),
+		Description: cmd.description,
+		Aliases:     cmd.aliases,
+		Examples:    cmd.examples,
+	}
+	if len(cmd.subcommands) > 0 {
+		h.Usage = fmt.Sprintf("%s <%s>", cmd.name, strings.Join(cmd.subcommandNames(), "|"))
+	}
+	for _, a := range cmd.args {
+		h.Arguments = append(h.Arguments, argHelp{Name: a.name, Description: a.description, Optional: a.optional})
+	}
+	for _, f := range cmd.flags {
+		h.Flags = append(h.Flags, flagHelp{Name: f.name, Value: f.value, Description: f.description})
+	}
+	for _, sub := range cmd.subcommands {
+		sub.name = cmd.name + " " + sub.name
+		h.Subcommands = append(h.Subcommands, newCommandHelp(sub))
+	}
+	return h
+}
+
+// summary lists a usage line with its description for the command, or
+// for each of its subcommands.
+func (h commandHelp) summary() []string {
+	if len(h.Subcommands) < 1 {
+		line := fmt.Sprintf("%s: %s", h.Usage, h.Description)
+		if len(h.Aliases) > 0 {
+			line += fmt.Sprintf(" (alias %s)", strings.Join(h.Aliases, ", "))
+		}
+		return []string{line}
+	}
+	lines := []string{}
+	for _, sub := range h.Subcommands {
+		lines = append(lines, sub.summary()...)
+	}
+	return lines
+}
+
+// printTable prints rows of two columns, the first padded to line up
+// the second.
+func printTable(rows [][2]string) {
+	width := 0
+	for _, r := range rows {
+		width = max(width, termui.VisibleWidth(r[0]))
+	}
+	for _, r := range rows {
+		fmt.Printf("  %s  %s\n", termui.PadRight(r[0], width), r[1])
+	}
+}
+
+func (h commandHelp) printText(ui termui.Terminal) {
+	fmt.Printf("Usage: %s\n\n", h.Usage)
+	fmt.Println(h.Description)
+	if len(h.Aliases) > 0 {
+		fmt.Printf("\nAliases: %s\n", strings.Join(h.Aliases, ", "))
+	}
+	if len(h.Arguments) > 0 {
+		rows := [][2]string{}
+		for _, a := range h.Arguments {
+			rows = append(rows, [2]string{a.Name, a.Description})
+		}
+		fmt.Println("\nArguments:")
+		printTable(rows)
+	}
+	if len(h.Flags) > 0 {
+		rows := [][2]string{}
+		for _, f := range h.Flags {
+			name := "--" + f.Name
+			if f.Value != "" {
+				name += " <" + f.Value + ">"
+			}
+			rows = append(rows, [2]string{name, f.Description})
+		}
+		fmt.Println("\nFlags:")
+		printTable(rows)
+	}
+	if len(h.Subcommands) > 0 {
+		rows := [][2]string{}
+		for _, sub := range h.Subcommands {
+			rows = append(rows, [2]string{sub.Usage, sub.Description})
+		}
+		fmt.Println("\nSubcommands:")
+		printTable(rows)
+	}
+	if len(h.Examples) > 0 {
+		fmt.Println("\nExamples:")
+		for _, e := range h.Examples {
+			fmt.Printf("  %s\n", e)
+		}
+	}
+}
+
+type helpCategory struct {
+	Name     string        `json:"name" yaml:"name"`
+	Commands []commandHelp `json:"commands" yaml:"commands"`
+}
+
+type helpResult struct {
+	Categories []helpCategory `json:"categories" yaml:"categories"`
+	Aliases    []aliasResult  `json:"aliases,omitempty" yaml:"aliases,omitempty"`
+}
+
+func newHelpResult(conf *config) helpResult {
+	r := helpResult{}
+	for _, category := range commandCategories {
+		c := helpCategory{Name: category}
+		for _, cmd := range sortedCommands(category, conf) {
+			c.Commands = append(c.Commands, newCommandHelp(cmd))
+		}
+		r.Categories = append(r.Categories, c)
+	}
+	for _, name := range aliasNames(conf) {
+		r.Aliases = append(r.Aliases, aliasResult{Name: name, Expansion: conf.settings.Aliases[name]})
+	}
+	return r
+}
+
+func (r helpResult) printText(ui termui.Terminal) {
+	res := "Usage:\n"
+	for _, c := range r.Categories {
+		res += fmt.Sprintf("\n%s:\n", heading(ui, c.Name))
+		for _, cmd := range c.Commands {
+			for _, line := range cmd.summary() {
+				res += "  " + line + "\n"
+			}
+		}
+	}
+	if len(r.Aliases) > 0 {
+		res += "\nAliases:\n"
+		for _, a := range r.Aliases {
+			res += fmt.Sprintf("  %s = %s\n", a.Name, a.Expansion)
+		}
+	}
+	res += "\nType help <command> for details about a command.\n"
+	fmt.Print(res)
+}
+
+func printHelp(conf *config) {
+	newHelpResult(conf).printText(conf.ui)
+}
+
+func runHelp(args commandArgs, conf *config) (result, error) {
+	name := args.get("command")
+	if name == "" {
+		return newHelpResult(conf), nil
+	}
+	cmd, ok := conf.commands[name]
+	if expansion, isAlias := conf.settings.Aliases[name]; !ok && isAlias {
+		return aliasResult{Name: name, Expansion: expansion}, nil
+	}
+	if !ok {
+		return nil, unknownCommandError(name, conf)
+	}
+	if sub := args.get("subcommand"); sub != "" {
+		var err error
+		cmd, _, err = cmd.resolve([]string{sub})
+		if err != nil {
+			return nil, err
+		}
+		cmd.name = name + " " + cmd.name
+	}
+	return newCommandHelp(cmd), nil
+}
+
+func unknownCommandError(name string, conf *config) error {
+	return fmt.Errorf("%w: %s%s", errUnknownCommand, name, didYouMean(name, commandNames(conf)))
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/dudiko2/pokedexcli/internal/lineedit"
+	"github.com/dudiko2/pokedexcli/internal/termui"
+)
+
+const (
+	historySizeEnvVar  = "POKEDEX_HISTORY_SIZE"
+	defaultHistorySize = 500
+)
+
+// stateDir follows the XDG base directory spec for files that should
+// persist between runs but aren't configuration.
+func stateDir() (string, error) {
+	dir := os.Getenv("XDG_STATE_HOME")
+	if dir != "" {
+		return filepath.Join(dir, "pokedexcli"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".local", "state", "pokedexcli"), nil
+}
+
+func historySize() int {
+	size, err := strconv.Atoi(os.Getenv(historySizeEnvVar))
+	if err != nil || size < 0 {
+		return defaultHistorySize
+	}
+	return size
+}
+
+// newHistory loads the saved history. Without a state dir, history is
+// kept for this session only.
+func newHistory() *lineedit.History {
+	path := ""
+	dir, err := stateDir()
+	if err == nil {
+		path = filepath.Join(dir, "history")
+	}
+	h := lineedit.NewHistory(historySize(), path)
+	err = h.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Could not load history: %v\n", err)
+	}
+	return h
+}
+
+type historyEntry struct {
+	Number  int    `json:"number" yaml:"number"`
+	Command string `json:"command" yaml:"command"`
+}
+
+type historyResult struct {
+	Entries []historyEntry `json:"entries" yaml:"entries"`
+	total   int
+}
+
+func (r historyResult) printText(ui termui.Terminal) {
+	if r.total < 1 {
+		fmt.Println("History is empty")
+		return
+	}
+	width := len(strconv.Itoa(r.total))
+	lines := []string{}
+	for _, e := range r.Entries {
+		lines = append(lines, fmt.Sprintf("%*d  %s", width, e.Number, e.Command))
+	}
+	fmt.Print(strings.Join(lines, "\n"))
+}
+
+func runHistory(args commandArgs, conf *config) (result, error) {
+	entries := conf.history.Entries()
+	start := 0
+	if count := args.get("count"); count != "" {
+		n, err := strconv.Atoi(count)
+		if err != nil || n < 0 {
+			return nil, errors.New("invalid argument: count")
+		}
+		start = max(0, len(entries)-n)
+	}
+	r := historyResult{
+		Entries: []historyEntry{},
+		total:   len(entries),
+	}
+	for i := start; i < len(entries); i++ {
+		r.Entries = append(r.Entries, historyEntry{Number: i + 1, Command: entries[i]})
+	}
+	return r, nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+)
+
+// idReplacer spells display names the way PokeAPI identifiers are
+// written, e.g. "Mr. Mime" -> "mr-mime" and "Nidoran♀" -> "nidoran-f".
+var idReplacer = strings.NewReplacer(
+	"♀", "-f",
+	"♂", "-m",
+	".", "",
+	"'", "",
+	"’", "",
+	":", "",
+	" ", "-",
+	"_", "-",
+)
+
+// normalizeID turns user input into the form PokeAPI identifiers take.
+// Numeric IDs pass through unchanged.
+func normalizeID(raw string) string {
+	id := strings.ToLower(strings.TrimSpace(raw))
+	id = idReplacer.Replace(id)
+	for strings.Contains(id, "--") {
+		id = strings.ReplaceAll(id, "--", "-")
+	}
+	return strings.Trim(id, "-")
+}
+
+func isNumericID(id string) bool {
+	n, err := strconv.Atoi(id)
+	return err == nil && n > 0
+}
+
+// caughtKey finds the key a caught Pokemon is stored under, given its
+// name in any spelling or its numeric ID.
+func caughtKey(raw string) (string, bool) {
+	id := normalizeID(raw)
+	if _, caught := caughtPokemon[id]; caught {
+		return id, true
+	}
+	if !isNumericID(id) {
+		return id, false
+	}
+	n, _ := strconv.Atoi(id)
+	for name, p := range caughtPokemon {
+		if p.ID == n {
+			return name, true
+		}
+	}
+	return id, false
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dudiko2/pokedexcli/internal/pokeapi"
+)
+
+func TestNormalizeID(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{"pikachu", "pikachu"},
+		{"  Pikachu ", "pikachu"},
+		{"PIKACHU", "pikachu"},
+		{"25", "25"},
+		{"Mr. Mime", "mr-mime"},
+		{"Farfetch'd", "farfetchd"},
+		{"Nidoran♀", "nidoran-f"},
+		{"Type: Null", "type-null"},
+		{"canalave_city  area", "canalave-city-area"},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			got := normalizeID(c.input)
+			if got != c.expected {
+				t.Errorf("expected %q for %q, got %q", c.expected, c.input, got)
+			}
+		})
+	}
+}
+
+func TestCaughtKey(t *testing.T) {
+	saved := caughtPokemon
+	t.Cleanup(func() {
+		caughtPokemon = saved
+	})
+	caughtPokemon = map[string]ownedPokemon{
+		"pikachu": {PokemonRes: pokeapi.PokemonRes{ID: 25, Name: "pikachu"}},
+	}
+	cases := []struct {
+		input  string
+		caught bool
+	}{
+		{"pikachu", true},
+		{"Pikachu", true},
+		{"25", true},
+		{"26", false},
+		{"raichu", false},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			key, caught := caughtKey(c.input)
+			if caught != c.caught {
+				t.Errorf("expected caught=%v for %q, got %v", c.caught, c.input, caught)
+				return
+			}
+			if caught && key != "pikachu" {
+				t.Errorf("expected key pikachu for %q, got %q", c.input, key)
+			}
+		})
+	}
+}
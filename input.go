@@ -1,8 +1,13 @@
 package main
 
 import (
-	"bufio"
+	"errors"
+	"fmt"
+	"os"
 	"strings"
+	"unicode"
+
+	"github.com/dudiko2/pokedexcli/internal/lineedit"
 )
 
 type parsedInput struct {
@@ -10,29 +15,161 @@ type parsedInput struct {
 	arguments []string
 }
 
-func parseInput(input string) parsedInput {
-	words := strings.Fields(input)
+// tokenize splits input into words the way a shell would: whitespace
+// separates words, single quotes keep everything literally, double
+// quotes allow \" and \\ escapes, and a backslash outside quotes escapes
+// the next character. Quotes may start mid-word, so --name="Mr Mime"
+// is the single word --name=Mr Mime.
+func tokenize(input string) ([]string, error) {
+	words := []string{}
+	var word strings.Builder
+	inWord := false
+	runes := []rune(input)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\\':
+			if i+1 >= len(runes) {
+				return nil, errors.New("trailing backslash")
+			}
+			i++
+			word.WriteRune(runes[i])
+			inWord = true
+		case r == '\'':
+			end := indexRune(runes, '\'', i+1)
+			if end < 0 {
+				return nil, fmt.Errorf("unbalanced single quote at column %v", i+1)
+			}
+			word.WriteString(string(runes[i+1 : end]))
+			i = end
+			inWord = true
+		case r == '"':
+			end, err := readDoubleQuoted(runes, i, &word)
+			if err != nil {
+				return nil, err
+			}
+			i = end
+			inWord = true
+		case unicode.IsSpace(r):
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+// readDoubleQuoted writes the contents of the double quoted string
+// starting at runes[start] to word and returns the closing quote's
+// index.
+func readDoubleQuoted(runes []rune, start int, word *strings.Builder) (int, error) {
+	for i := start + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case '"':
+			return i, nil
+		case '\\':
+			if i+1 < len(runes) && (runes[i+1] == '"' || runes[i+1] == '\\') {
+				i++
+			}
+		}
+		word.WriteRune(runes[i])
+	}
+	return 0, fmt.Errorf("unbalanced double quote at column %v", start+1)
+}
+
+func indexRune(runes []rune, r rune, from int) int {
+	for i := from; i < len(runes); i++ {
+		if runes[i] == r {
+			return i
+		}
+	}
+	return -1
+}
+
+// splitCommands splits input on every ; that isn't quoted or escaped,
+// keeping each command's text as typed.
+func splitCommands(input string) ([]string, error) {
+	commands := []string{}
+	runes := []rune(input)
+	start := 0
+	var quote rune
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case quote == 0 && r == '\\', quote == '"' && r == '\\':
+			i++
+		case quote == 0 && (r == '\'' || r == '"'):
+			quote = r
+		case r == quote:
+			quote = 0
+		case quote == 0 && r == ';':
+			commands = append(commands, string(runes[start:i]))
+			start = i + 1
+		}
+	}
+	if quote != 0 {
+		return nil, errors.New("unbalanced quote")
+	}
+	commands = append(commands, string(runes[start:]))
+	return commands, nil
+}
+
+func parseInput(input string) (parsedInput, error) {
+	words, err := tokenize(input)
+	if err != nil {
+		return parsedInput{}, err
+	}
 	wordsLen := len(words)
 	if wordsLen < 1 {
-		return parsedInput{}
+		return parsedInput{}, nil
 	}
 	if wordsLen < 2 {
 		return parsedInput{
 			command:   words[0],
 			arguments: nil,
-		}
+		}, nil
 	}
 	p := parsedInput{
 		command:   words[0],
 		arguments: words[1:],
 	}
-	return p
+	return p, nil
 }
 
-func prepareInput(scanner *bufio.Scanner) parsedInput {
-	scanner.Scan()
-	input := scanner.Text()
-	sanitized := strings.TrimSpace(input)
-	parsed := parseInput(sanitized)
-	return parsed
+// prepareInput reads a line, expands history references like !! in it
+// and records it in the history.
+func prepareInput(editor *lineedit.Editor, history *lineedit.History) (parsedInput, error) {
+	input, err := editor.ReadLine(prompt)
+	if err != nil {
+		return parsedInput{}, err
+	}
+	expanded, err := history.Expand(input)
+	if err != nil {
+		fmt.Printf("Error: %v\n\n", err)
+		return parsedInput{}, nil
+	}
+	if expanded != input {
+		fmt.Println(expanded)
+	}
+	if editor.IsTerminal() {
+		err = history.Add(expanded)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Could not save history: %v\n", err)
+		}
+	}
+	sanitized := strings.TrimSpace(expanded)
+	parsed, err := parseInput(sanitized)
+	if err != nil {
+		fmt.Printf("Error: %v\n\n", err)
+		return parsedInput{}, nil
+	}
+	return parsed, nil
 }
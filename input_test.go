@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected []string
+	}{
+		{"", []string{}},
+		{"   ", []string{}},
+		{"catch pikachu", []string{"catch", "pikachu"}},
+		{"  catch   pikachu  ", []string{"catch", "pikachu"}},
+		{`catch "mr mime"`, []string{"catch", "mr mime"}},
+		{`catch 'mr mime'`, []string{"catch", "mr mime"}},
+		{`catch mr\ mime`, []string{"catch", "mr mime"}},
+		{`say "she said \"hi\""`, []string{"say", `she said "hi"`}},
+		{`say "back\\slash"`, []string{"say", `back\slash`}},
+		{`say "keep \n as is"`, []string{"say", `keep \n as is`}},
+		{`say 'no \"escapes\" here'`, []string{"say", `no \"escapes\" here`}},
+		{`say ""`, []string{"say", ""}},
+		{`--name="Mr Mime"`, []string{"--name=Mr Mime"}},
+		{`--name='a b'c`, []string{"--name=a bc"}},
+		{`--region=kanto`, []string{"--region=kanto"}},
+		{"lang ja-Hrkt", []string{"lang", "ja-Hrkt"}},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			got, err := tokenize(c.input)
+			if err != nil {
+				t.Errorf("unexpected error for %q: %v", c.input, err)
+				return
+			}
+			if !slices.Equal(got, c.expected) {
+				t.Errorf("expected %q for %q, got %q", c.expected, c.input, got)
+			}
+		})
+	}
+}
+
+func TestTokenizeErrors(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{`catch "mr mime`, "unbalanced double quote at column 7"},
+		{`catch 'mr mime`, "unbalanced single quote at column 7"},
+		{`catch mr"`, "unbalanced double quote at column 9"},
+		{`catch pikachu\`, "trailing backslash"},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			_, err := tokenize(c.input)
+			if err == nil {
+				t.Errorf("expected an error for %q", c.input)
+				return
+			}
+			if err.Error() != c.expected {
+				t.Errorf("expected error %q for %q, got %q", c.expected, c.input, err)
+			}
+		})
+	}
+}
+
+func TestSplitCommands(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected []string
+		fails    bool
+	}{
+		{"catch pikachu", []string{"catch pikachu"}, false},
+		{"catch pikachu; inspect pikachu", []string{"catch pikachu", " inspect pikachu"}, false},
+		{`say "a;b"; map`, []string{`say "a;b"`, " map"}, false},
+		{`say 'a;b'`, []string{`say 'a;b'`}, false},
+		{`say a\;b`, []string{`say a\;b`}, false},
+		{`say "a\";b"`, []string{`say "a\";b"`}, false},
+		{`say "a;b`, nil, true},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			got, err := splitCommands(c.input)
+			if (err != nil) != c.fails {
+				t.Errorf("expected failure=%v for %q, got %v", c.fails, c.input, err)
+				return
+			}
+			if !slices.Equal(got, c.expected) {
+				t.Errorf("expected %q for %q, got %q", c.expected, c.input, got)
+			}
+		})
+	}
+}
+
+func TestParseInput(t *testing.T) {
+	p, err := parseInput(`catch "mr mime" --ball=great`)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if p.command != "catch" || !slices.Equal(p.arguments, []string{"mr mime", "--ball=great"}) {
+		t.Errorf("unexpected parse: %+v", p)
+	}
+}
@@ -0,0 +1,92 @@
+package fuzzy
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// Distance returns the Levenshtein edit distance between a and b.
+func Distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// maxDistance is how many edits a suggestion may be away from the
+// query: short names need to be close, longer ones get more slack.
+func maxDistance(query string) int {
+	return max(2, len([]rune(query))/3)
+}
+
+// Suggest returns up to limit candidates close to query, closest first.
+func Suggest(query string, candidates []string, limit int) []string {
+	type match struct {
+		name string
+		dist int
+	}
+	query = strings.ToLower(query)
+	threshold := maxDistance(query)
+	matches := []match{}
+	for _, c := range candidates {
+		d := Distance(query, c)
+		if d <= threshold {
+			matches = append(matches, match{name: c, dist: d})
+		}
+	}
+	slices.SortFunc(matches, func(a, b match) int {
+		if byDist := cmp.Compare(a.dist, b.dist); byDist != 0 {
+			return byDist
+		}
+		return cmp.Compare(a.name, b.name)
+	})
+	names := []string{}
+	for i := 0; i < len(matches) && i < limit; i++ {
+		names = append(names, matches[i].name)
+	}
+	return names
+}
+
+// UniquePrefix returns the candidate that query is an unambiguous prefix
+// of. When several candidates match, the shortest one still wins if it
+// is a prefix of all the others, so "pikach" picks "pikachu" over its
+// forms such as "pikachu-rock-star".
+func UniquePrefix(query string, candidates []string) (string, bool) {
+	query = strings.ToLower(query)
+	if query == "" {
+		return "", false
+	}
+	matches := []string{}
+	for _, c := range candidates {
+		if strings.HasPrefix(c, query) {
+			matches = append(matches, c)
+		}
+	}
+	if len(matches) < 1 {
+		return "", false
+	}
+	shortest := slices.MinFunc(matches, func(a, b string) int {
+		return cmp.Compare(len(a), len(b))
+	})
+	for _, m := range matches {
+		if !strings.HasPrefix(m, shortest) {
+			return "", false
+		}
+	}
+	return shortest, true
+}
@@ -0,0 +1,81 @@
+package fuzzy
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+var names = []string{
+	"pikachu",
+	"pikachu-rock-star",
+	"pikachu-belle",
+	"pichu",
+	"raichu",
+	"bulbasaur",
+	"ivysaur",
+}
+
+func TestDistance(t *testing.T) {
+	cases := []struct {
+		a, b string
+		dist int
+	}{
+		{"", "", 0},
+		{"pikachu", "pikachu", 0},
+		{"pikchu", "pikachu", 1},
+		{"pikahcu", "pikachu", 2},
+		{"kitten", "sitting", 3},
+		{"", "abc", 3},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			d := Distance(c.a, c.b)
+			if d != c.dist {
+				t.Errorf("expected distance %v between %q and %q, got %v", c.dist, c.a, c.b, d)
+			}
+		})
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	cases := []struct {
+		query    string
+		expected []string
+	}{
+		{"pikchu", []string{"pichu", "pikachu"}},
+		{"Bulbasuar", []string{"bulbasaur"}},
+		{"zzzzzz", []string{}},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			got := Suggest(c.query, names, 3)
+			if !slices.Equal(got, c.expected) {
+				t.Errorf("expected %v for %q, got %v", c.expected, c.query, got)
+			}
+		})
+	}
+}
+
+func TestUniquePrefix(t *testing.T) {
+	cases := []struct {
+		query    string
+		expected string
+		ok       bool
+	}{
+		{"pikach", "pikachu", true},
+		{"pikachu-r", "pikachu-rock-star", true},
+		{"bulb", "bulbasaur", true},
+		{"pi", "", false},
+		{"x", "", false},
+		{"", "", false},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			got, ok := UniquePrefix(c.query, names)
+			if ok != c.ok || got != c.expected {
+				t.Errorf("expected (%q, %v) for %q, got (%q, %v)", c.expected, c.ok, c.query, got, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package lineedit
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// History is a bounded list of entered lines, oldest first. Repeating
+// the previous line doesn't add a new entry.
+type History struct {
+	entries []string
+	size    int
+	path    string
+}
+
+// NewHistory creates a history that keeps at most size lines and saves
+// them to path. An empty path keeps history in memory only.
+func NewHistory(size int, path string) *History {
+	h := History{
+		size: size,
+		path: path,
+	}
+	return &h
+}
+
+// Load reads the history file, if there is one.
+func (h *History) Load() error {
+	if h.path == "" {
+		return nil
+	}
+	f, err := os.Open(h.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		h.add(scanner.Text())
+	}
+	return scanner.Err()
+}
+
+func (h *History) save() error {
+	if h.path == "" {
+		return nil
+	}
+	err := os.MkdirAll(filepath.Dir(h.path), 0o755)
+	if err != nil {
+		return err
+	}
+	data := strings.Join(h.entries, "\n") + "\n"
+	return os.WriteFile(h.path, []byte(data), 0o600)
+}
+
+func (h *History) add(line string) bool {
+	if strings.TrimSpace(line) == "" || h.size < 1 {
+		return false
+	}
+	if n := len(h.entries); n > 0 && h.entries[n-1] == line {
+		return false
+	}
+	h.entries = append(h.entries, line)
+	if extra := len(h.entries) - h.size; extra > 0 {
+		h.entries = h.entries[extra:]
+	}
+	return true
+}
+
+// Add records line and saves the history file.
+func (h *History) Add(line string) error {
+	if !h.add(line) {
+		return nil
+	}
+	return h.save()
+}
+
+// Entries returns the history, oldest first. Entry i is shown as
+// number i+1.
+func (h *History) Entries() []string {
+	return h.entries
+}
+
+// Expand replaces a line of the form !! or !n with the previous or the
+// nth history entry. Other lines are returned unchanged.
+func (h *History) Expand(line string) (string, error) {
+	trimmed := strings.TrimSpace(line)
+	if !strings.HasPrefix(trimmed, "!") {
+		return line, nil
+	}
+	ref, rest, _ := strings.Cut(trimmed[1:], " ")
+	var entry string
+	if ref == "!" {
+		if len(h.entries) < 1 {
+			return "", errors.New("!!: event not found")
+		}
+		entry = h.entries[len(h.entries)-1]
+	} else {
+		n, err := strconv.Atoi(ref)
+		if err != nil {
+			return line, nil
+		}
+		if n < 0 {
+			n = len(h.entries) + n + 1
+		}
+		if n < 1 || n > len(h.entries) {
+			return "", fmt.Errorf("!%s: event not found", ref)
+		}
+		entry = h.entries[n-1]
+	}
+	if rest != "" {
+		entry += " " + rest
+	}
+	return entry, nil
+}
+
+// searchBack returns the index of the newest entry before from that
+// contains query.
+func (h *History) searchBack(query string, from int) (int, bool) {
+	for i := min(from, len(h.entries)) - 1; i >= 0; i-- {
+		if strings.Contains(h.entries[i], query) {
+			return i, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,460 @@
+// Package lineedit reads lines from a terminal with basic editing and
+// tab completion. When input isn't a terminal it reads plain lines.
+package lineedit
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+
+	"golang.org/x/term"
+)
+
+// ErrInterrupted is returned by ReadLine when the user presses Ctrl-C.
+var ErrInterrupted = errors.New("interrupted")
+
+// Completer returns every candidate for the word being typed. before is
+// the line up to the cursor; candidates replace its last word.
+type Completer func(before string) []string
+
+type Editor struct {
+	in       *os.File
+	out      io.Writer
+	reader   *bufio.Reader
+	Complete Completer
+	// History, if set, is browsed with the arrow keys and searched with
+	// Ctrl-R. Lines aren't added to it automatically.
+	History *History
+}
+
+func New(in *os.File, out io.Writer) *Editor {
+	e := Editor{
+		in:     in,
+		out:    out,
+		reader: bufio.NewReader(in),
+	}
+	return &e
+}
+
+// IsTerminal reports whether input comes from a terminal, as opposed to
+// a pipe or file.
+func (e *Editor) IsTerminal() bool {
+	return term.IsTerminal(int(e.in.Fd()))
+}
+
+// ReadLine shows prompt and returns the next line without its line
+// ending. It returns io.EOF at the end of input.
+func (e *Editor) ReadLine(prompt string) (string, error) {
+	if !e.IsTerminal() {
+		fmt.Fprint(e.out, prompt)
+		return e.readPlainLine()
+	}
+	fd := int(e.in.Fd())
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		fmt.Fprint(e.out, prompt)
+		return e.readPlainLine()
+	}
+	defer term.Restore(fd, oldState)
+	return e.readEditedLine(prompt)
+}
+
+func (e *Editor) readPlainLine() (string, error) {
+	line, err := e.reader.ReadString('\n')
+	if err != nil && (line == "" || !errors.Is(err, io.EOF)) {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+const (
+	keyCtrlA     = 1
+	keyCtrlB     = 2
+	keyCtrlC     = 3
+	keyCtrlD     = 4
+	keyCtrlE     = 5
+	keyCtrlF     = 6
+	keyBackspace = 8
+	keyTab       = 9
+	keyCtrlK     = 11
+	keyCtrlG     = 7
+	keyCtrlL     = 12
+	keyEnter     = 13
+	keyCtrlN     = 14
+	keyCtrlP     = 16
+	keyCtrlR     = 18
+	keyCtrlU     = 21
+	keyCtrlW     = 23
+	keyEscape    = 27
+	keyDelete    = 127
+
+	// escape sequences are decoded to these, outside the Unicode range
+	keyUp       = -1
+	keyDown     = -2
+	keyLeft     = -3
+	keyRight    = -4
+	keyHome     = -5
+	keyEnd      = -6
+	keyDel      = -7
+	keyNone     = -8
+	keyPageUp   = -9
+	keyPageDown = -10
+)
+
+// Keys ReadKey decodes from escape sequences. Other keys are returned
+// as typed, control characters included.
+const (
+	KeyUp       = keyUp
+	KeyDown     = keyDown
+	KeyLeft     = keyLeft
+	KeyRight    = keyRight
+	KeyHome     = keyHome
+	KeyEnd      = keyEnd
+	KeyDelete   = keyDel
+	KeyPageUp   = keyPageUp
+	KeyPageDown = keyPageDown
+	KeyUnknown  = keyNone
+)
+
+// ReadKey reads one key press for programs that handle keys themselves.
+// The terminal should already be in raw mode.
+func (e *Editor) ReadKey() (rune, error) {
+	return e.readKey()
+}
+
+// Pending reports whether more key presses have already arrived, such
+// as when a key is held down.
+func (e *Editor) Pending() bool {
+	return e.reader.Buffered() > 0
+}
+
+// readKey reads one key press, decoding escape sequences.
+func (e *Editor) readKey() (rune, error) {
+	r, _, err := e.reader.ReadRune()
+	if err != nil || r != keyEscape {
+		return r, err
+	}
+	r, _, err = e.reader.ReadRune()
+	if err != nil || (r != '[' && r != 'O') {
+		return keyNone, err
+	}
+	r, _, err = e.reader.ReadRune()
+	if err != nil {
+		return keyNone, err
+	}
+	switch r {
+	case 'A':
+		return keyUp, nil
+	case 'B':
+		return keyDown, nil
+	case 'C':
+		return keyRight, nil
+	case 'D':
+		return keyLeft, nil
+	case 'H':
+		return keyHome, nil
+	case 'F':
+		return keyEnd, nil
+	case '3':
+		e.reader.ReadRune() // trailing '~'
+		return keyDel, nil
+	case '5':
+		e.reader.ReadRune()
+		return keyPageUp, nil
+	case '6':
+		e.reader.ReadRune()
+		return keyPageDown, nil
+	}
+	return keyNone, nil
+}
+
+// historyCursor walks the history from the newest entry, remembering
+// the line that was being typed before browsing started.
+type historyCursor struct {
+	history *History
+	index   int
+	draft   string
+}
+
+func (c *historyCursor) reset() {
+	c.index = len(c.entries())
+}
+
+func (c *historyCursor) entries() []string {
+	if c.history == nil {
+		return nil
+	}
+	return c.history.entries
+}
+
+func (c *historyCursor) older(b *buffer) {
+	if c.index < 1 {
+		return
+	}
+	if c.index == len(c.entries()) {
+		c.draft = b.String()
+	}
+	c.index--
+	b.set(c.entries()[c.index])
+}
+
+func (c *historyCursor) newer(b *buffer) {
+	if c.index >= len(c.entries()) {
+		return
+	}
+	c.index++
+	if c.index == len(c.entries()) {
+		b.set(c.draft)
+		return
+	}
+	b.set(c.entries()[c.index])
+}
+
+func (e *Editor) readEditedLine(prompt string) (string, error) {
+	b := buffer{}
+	hist := historyCursor{history: e.History}
+	hist.reset()
+	lastWasTab := false
+	e.render(prompt, &b)
+	for {
+		r, err := e.readKey()
+		if err != nil {
+			return "", err
+		}
+		isTab := r == keyTab
+		switch r {
+		case keyEnter, '\n':
+			fmt.Fprint(e.out, "\r\n")
+			return b.String(), nil
+		case keyCtrlC:
+			fmt.Fprint(e.out, "^C\r\n")
+			return "", ErrInterrupted
+		case keyCtrlD:
+			if len(b.line) < 1 {
+				fmt.Fprint(e.out, "\r\n")
+				return "", io.EOF
+			}
+			b.deleteForward()
+		case keyBackspace, keyDelete:
+			b.deleteBackward()
+		case keyDel:
+			b.deleteForward()
+		case keyTab:
+			e.complete(prompt, &b, lastWasTab)
+		case keyCtrlA, keyHome:
+			b.pos = 0
+		case keyCtrlE, keyEnd:
+			b.pos = len(b.line)
+		case keyCtrlB, keyLeft:
+			b.moveLeft()
+		case keyCtrlF, keyRight:
+			b.moveRight()
+		case keyCtrlP, keyUp:
+			hist.older(&b)
+		case keyCtrlN, keyDown:
+			hist.newer(&b)
+		case keyCtrlR:
+			run, err := e.reverseSearch(&b)
+			if err != nil {
+				return "", err
+			}
+			if run {
+				e.render(prompt, &b)
+				fmt.Fprint(e.out, "\r\n")
+				return b.String(), nil
+			}
+		case keyCtrlK:
+			b.line = b.line[:b.pos]
+		case keyCtrlU:
+			b.line = b.line[b.pos:]
+			b.pos = 0
+		case keyCtrlW:
+			b.deleteWord()
+		case keyCtrlL:
+			fmt.Fprint(e.out, "\x1b[H\x1b[2J")
+		default:
+			if unicode.IsPrint(r) {
+				b.insert(string(r))
+			}
+		}
+		lastWasTab = isTab
+		e.render(prompt, &b)
+	}
+}
+
+// reverseSearch runs a Ctrl-R incremental search over the history. It
+// leaves the match in b and reports whether the user pressed Enter to
+// run it right away. Ctrl-G or Ctrl-C cancel, leaving b as it was.
+func (e *Editor) reverseSearch(b *buffer) (bool, error) {
+	if e.History == nil {
+		return false, nil
+	}
+	original := b.String()
+	query := []rune{}
+	match := ""
+	index := len(e.History.entries)
+	failed := false
+	search := func(from int) {
+		i, ok := e.History.searchBack(string(query), from)
+		failed = !ok
+		if ok {
+			index = i
+			match = e.History.entries[i]
+		}
+	}
+	for {
+		label := "reverse-i-search"
+		if failed {
+			label = "failed reverse-i-search"
+		}
+		fmt.Fprintf(e.out, "\r(%s)`%s': %s\x1b[K", label, string(query), match)
+		r, err := e.readKey()
+		if err != nil {
+			return false, err
+		}
+		switch {
+		case r == keyCtrlR:
+			search(index)
+		case r == keyBackspace || r == keyDelete:
+			if len(query) > 0 {
+				query = query[:len(query)-1]
+			}
+			search(len(e.History.entries))
+		case r == keyCtrlG || r == keyCtrlC:
+			b.set(original)
+			return false, nil
+		case r == keyEnter || r == '\n':
+			b.set(match)
+			return true, nil
+		case r >= 0 && unicode.IsPrint(r):
+			query = append(query, r)
+			search(index + 1)
+		default:
+			b.set(match)
+			return false, nil
+		}
+	}
+}
+
+func (e *Editor) render(prompt string, b *buffer) {
+	fmt.Fprintf(e.out, "\r%s%s\x1b[K", prompt, b.String())
+	if back := len(b.line) - b.pos; back > 0 {
+		fmt.Fprintf(e.out, "\x1b[%vD", back)
+	}
+}
+
+// complete replaces the word before the cursor with its only candidate,
+// or with the candidates' common prefix. A second Tab in a row lists
+// the candidates.
+func (e *Editor) complete(prompt string, b *buffer, listCandidates bool) {
+	if e.Complete == nil {
+		return
+	}
+	before := string(b.line[:b.pos])
+	candidates := e.Complete(before)
+	if len(candidates) < 1 {
+		return
+	}
+	word := lastWord(before)
+	if len(candidates) == 1 {
+		b.replaceBeforeCursor(len([]rune(word)), candidates[0]+" ")
+		return
+	}
+	prefix := commonPrefix(candidates)
+	if len(prefix) > len(word) {
+		b.replaceBeforeCursor(len([]rune(word)), prefix)
+		return
+	}
+	if listCandidates {
+		fmt.Fprintf(e.out, "\r\n%s\r\n", strings.Join(candidates, "  "))
+	}
+}
+
+func lastWord(s string) string {
+	i := strings.LastIndexAny(s, " \t")
+	return s[i+1:]
+}
+
+func commonPrefix(words []string) string {
+	prefix := words[0]
+	for _, w := range words[1:] {
+		for !strings.HasPrefix(w, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
+
+// buffer is the line being edited and the cursor position in runes.
+type buffer struct {
+	line []rune
+	pos  int
+}
+
+// set replaces the whole line and moves the cursor to its end.
+func (b *buffer) set(s string) {
+	b.line = []rune(s)
+	b.pos = len(b.line)
+}
+
+func (b *buffer) String() string {
+	return string(b.line)
+}
+
+func (b *buffer) insert(s string) {
+	r := []rune(s)
+	tail := append(r, b.line[b.pos:]...)
+	b.line = append(b.line[:b.pos], tail...)
+	b.pos += len(r)
+}
+
+func (b *buffer) deleteBackward() {
+	if b.pos < 1 {
+		return
+	}
+	b.line = append(b.line[:b.pos-1], b.line[b.pos:]...)
+	b.pos--
+}
+
+func (b *buffer) deleteForward() {
+	if b.pos >= len(b.line) {
+		return
+	}
+	b.line = append(b.line[:b.pos], b.line[b.pos+1:]...)
+}
+
+func (b *buffer) deleteWord() {
+	start := b.pos
+	for start > 0 && b.line[start-1] == ' ' {
+		start--
+	}
+	for start > 0 && b.line[start-1] != ' ' {
+		start--
+	}
+	b.line = append(b.line[:start], b.line[b.pos:]...)
+	b.pos = start
+}
+
+func (b *buffer) moveLeft() {
+	if b.pos > 0 {
+		b.pos--
+	}
+}
+
+func (b *buffer) moveRight() {
+	if b.pos < len(b.line) {
+		b.pos++
+	}
+}
+
+// replaceBeforeCursor swaps the n runes before the cursor for s.
+func (b *buffer) replaceBeforeCursor(n int, s string) {
+	start := b.pos - n
+	tail := append([]rune(s), b.line[b.pos:]...)
+	b.line = append(b.line[:start], tail...)
+	b.pos = start + len([]rune(s))
+}
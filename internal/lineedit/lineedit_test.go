@@ -0,0 +1,123 @@
+package lineedit
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestBufferEditing(t *testing.T) {
+	b := buffer{}
+	b.insert("catch pikchu")
+	b.moveLeft()
+	b.moveLeft()
+	b.moveLeft()
+	b.insert("a")
+	if b.String() != "catch pikachu" {
+		t.Errorf("expected %q, got %q", "catch pikachu", b.String())
+		return
+	}
+	b.pos = len(b.line)
+	b.deleteWord()
+	if b.String() != "catch " {
+		t.Errorf("expected %q, got %q", "catch ", b.String())
+		return
+	}
+	b.deleteBackward()
+	if b.String() != "catch" || b.pos != 5 {
+		t.Errorf("expected %q at 5, got %q at %v", "catch", b.String(), b.pos)
+	}
+}
+
+func TestCommonPrefix(t *testing.T) {
+	cases := []struct {
+		words    []string
+		expected string
+	}{
+		{[]string{"map"}, "map"},
+		{[]string{"map", "mapb"}, "map"},
+		{[]string{"pikachu", "pichu", "pidgey"}, "pi"},
+		{[]string{"catch", "explore"}, ""},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			got := commonPrefix(c.words)
+			if got != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestComplete(t *testing.T) {
+	e := Editor{
+		Complete: func(before string) []string {
+			return []string{"pikachu"}
+		},
+	}
+	b := buffer{}
+	b.insert("catch pik")
+	e.complete("", &b, false)
+	if b.String() != "catch pikachu " {
+		t.Errorf("expected %q, got %q", "catch pikachu ", b.String())
+	}
+}
+
+func TestHistoryAdd(t *testing.T) {
+	h := NewHistory(3, "")
+	for _, line := range []string{"map", "map", "", "explore", "catch pikachu", "inspect pikachu"} {
+		h.Add(line)
+	}
+	expected := []string{"explore", "catch pikachu", "inspect pikachu"}
+	if !slices.Equal(h.Entries(), expected) {
+		t.Errorf("expected %v, got %v", expected, h.Entries())
+	}
+}
+
+func TestHistoryExpand(t *testing.T) {
+	h := NewHistory(10, "")
+	h.Add("map")
+	h.Add("catch pikachu")
+	cases := []struct {
+		input    string
+		expected string
+		fails    bool
+	}{
+		{"!!", "catch pikachu", false},
+		{"!1", "map", false},
+		{"!-2", "map", false},
+		{"!1 extra", "map extra", false},
+		{"!3", "", true},
+		{"map", "map", false},
+		{"!help", "!help", false},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			got, err := h.Expand(c.input)
+			if (err != nil) != c.fails {
+				t.Errorf("expected failure=%v for %q, got %v", c.fails, c.input, err)
+				return
+			}
+			if got != c.expected {
+				t.Errorf("expected %q for %q, got %q", c.expected, c.input, got)
+			}
+		})
+	}
+}
+
+func TestHistorySaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history")
+	h := NewHistory(10, path)
+	h.Add("map")
+	h.Add("explore")
+	loaded := NewHistory(10, path)
+	err := loaded.Load()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if !slices.Equal(loaded.Entries(), h.Entries()) {
+		t.Errorf("expected %v, got %v", h.Entries(), loaded.Entries())
+	}
+}
@@ -0,0 +1,24 @@
+package pokeapi
+
+import "fmt"
+
+type EggGroupRes struct {
+	ID             int             `json:"id"`
+	Name           string          `json:"name"`
+	Names          []LocationNames `json:"names"`
+	PokemonSpecies []NameURLPair   `json:"pokemon_species"`
+}
+
+func (c *Client) GetEggGroup(id string) (EggGroupRes, error) {
+	path := fmt.Sprintf("egg-group/%s", id)
+	key := baseURL + path
+	body, err := c.cachedGetData(key)
+	if err != nil {
+		return EggGroupRes{}, err
+	}
+	group, err := parseJSON[EggGroupRes](body)
+	if err != nil {
+		return EggGroupRes{}, err
+	}
+	return group, nil
+}
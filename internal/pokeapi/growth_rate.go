@@ -0,0 +1,35 @@
+package pokeapi
+
+import "fmt"
+
+type GrowthRateRes struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Formula      string `json:"formula"`
+	Descriptions []struct {
+		Description string       `json:"description"`
+		Language    LanguageInfo `json:"language"`
+	} `json:"descriptions"`
+	Levels         []GrowthRateLevel `json:"levels"`
+	PokemonSpecies []NameURLPair     `json:"pokemon_species"`
+}
+
+// GrowthRateLevel is the total experience needed to reach Level.
+type GrowthRateLevel struct {
+	Level      int `json:"level"`
+	Experience int `json:"experience"`
+}
+
+func (c *Client) GetGrowthRate(id string) (GrowthRateRes, error) {
+	path := fmt.Sprintf("growth-rate/%s", id)
+	key := baseURL + path
+	body, err := c.cachedGetData(key)
+	if err != nil {
+		return GrowthRateRes{}, err
+	}
+	rate, err := parseJSON[GrowthRateRes](body)
+	if err != nil {
+		return GrowthRateRes{}, err
+	}
+	return rate, nil
+}
@@ -0,0 +1,26 @@
+package pokeapi
+
+import "fmt"
+
+type ItemRes struct {
+	ID                int             `json:"id"`
+	Name              string          `json:"name"`
+	Cost              int             `json:"cost"`
+	Category          NameURLPair     `json:"category"`
+	Names             []LocationNames `json:"names"`
+	FlavorTextEntries []FlavorText    `json:"flavor_text_entries"`
+}
+
+func (c *Client) GetItem(id string) (ItemRes, error) {
+	path := fmt.Sprintf("item/%s", id)
+	key := baseURL + path
+	body, err := c.cachedGetData(key)
+	if err != nil {
+		return ItemRes{}, err
+	}
+	item, err := parseJSON[ItemRes](body)
+	if err != nil {
+		return ItemRes{}, err
+	}
+	return item, nil
+}
@@ -0,0 +1,80 @@
+package pokeapi
+
+import (
+	"fmt"
+	"strings"
+)
+
+const DefaultLanguage = "en"
+
+type LanguageRes struct {
+	ID       int             `json:"id"`
+	Name     string          `json:"name"`
+	Official bool            `json:"official"`
+	ISO639   string          `json:"iso639"`
+	ISO3166  string          `json:"iso3166"`
+	Names    []LocationNames `json:"names"`
+}
+
+// LocalizedResource decodes just the name fields shared by most
+// resources, for use with Resolve when only the display name is needed.
+type LocalizedResource struct {
+	Name  string          `json:"name"`
+	Names []LocationNames `json:"names"`
+}
+
+// FlavorText is a description entry. Species and moves use flavor_text,
+// items use text.
+type FlavorText struct {
+	FlavorText   string       `json:"flavor_text"`
+	Text         string       `json:"text"`
+	Language     LanguageInfo `json:"language"`
+	Version      *NameURLPair `json:"version"`
+	VersionGroup *NameURLPair `json:"version_group"`
+}
+
+func (c *Client) GetLanguage(id string) (LanguageRes, error) {
+	path := fmt.Sprintf("language/%s", id)
+	key := baseURL + path
+	body, err := c.cachedGetData(key)
+	if err != nil {
+		return LanguageRes{}, err
+	}
+	language, err := parseJSON[LanguageRes](body)
+	if err != nil {
+		return LanguageRes{}, err
+	}
+	return language, nil
+}
+
+// LocalizedName returns the name in lang, falling back to English. It
+// returns false if neither is available.
+func LocalizedName(names []LocationNames, lang string) (string, bool) {
+	for _, l := range []string{lang, DefaultLanguage} {
+		for _, n := range names {
+			if n.Language.Name == l {
+				return n.Name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// LocalizedFlavorText returns the most recent description in lang,
+// falling back to English, with the game's hard line breaks removed.
+func LocalizedFlavorText(entries []FlavorText, lang string) (string, bool) {
+	for _, l := range []string{lang, DefaultLanguage} {
+		for i := len(entries) - 1; i >= 0; i-- {
+			e := entries[i]
+			if e.Language.Name != l {
+				continue
+			}
+			text := e.FlavorText
+			if text == "" {
+				text = e.Text
+			}
+			return strings.Join(strings.Fields(text), " "), true
+		}
+	}
+	return "", false
+}
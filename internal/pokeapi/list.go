@@ -0,0 +1,121 @@
+package pokeapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const defaultPageSize = 100
+
+// ResourceList is one page of a list endpoint. T is NameURLPair for
+// named resources and APIResource for unnamed ones such as machines.
+type ResourceList[T any] struct {
+	Count    int     `json:"count"`
+	Next     *string `json:"next"`
+	Previous *string `json:"previous"`
+	Results  []T     `json:"results"`
+}
+
+// ListURL builds the URL of a page of endpoint, e.g. ListURL("pokemon",
+// 0, 20).
+func ListURL(endpoint string, offset, limit int) string {
+	endpoint = strings.Trim(endpoint, "/")
+	return fmt.Sprintf("%s%s?offset=%v&limit=%v", baseURL, endpoint, offset, limit)
+}
+
+// GetPage fetches a single page by URL, as built by ListURL or returned
+// in a previous page's Next and Previous links.
+func GetPage[T any](ctx context.Context, c *Client, pageURL string) (ResourceList[T], error) {
+	if !strings.HasPrefix(pageURL, baseURL) {
+		return ResourceList[T]{}, fmt.Errorf("not a PokeAPI url: %s", pageURL)
+	}
+	body, err := c.cachedGetDataContext(ctx, pageURL)
+	if err != nil {
+		return ResourceList[T]{}, err
+	}
+	return parseJSON[ResourceList[T]](body)
+}
+
+// Pager walks every entry of a list endpoint, following next links as
+// it goes. It is used like bufio.Scanner:
+//
+//	p := List[NameURLPair](ctx, c, "pokemon")
+//	for p.Next() {
+//		fmt.Println(p.Item().Name)
+//	}
+//	if err := p.Err(); err != nil {
+//		...
+//	}
+type Pager[T any] struct {
+	ctx     context.Context
+	client  *Client
+	nextURL *string
+	page    []T
+	item    T
+	count   int
+	err     error
+}
+
+func List[T any](ctx context.Context, c *Client, endpoint string) *Pager[T] {
+	first := ListURL(endpoint, 0, defaultPageSize)
+	p := Pager[T]{
+		ctx:     ctx,
+		client:  c,
+		nextURL: &first,
+	}
+	return &p
+}
+
+// Next advances to the next entry, fetching the next page when the
+// current one is used up. It returns false at the end of the list or on
+// the first error.
+func (p *Pager[T]) Next() bool {
+	if p.err != nil {
+		return false
+	}
+	for len(p.page) < 1 {
+		if p.nextURL == nil {
+			return false
+		}
+		if err := p.ctx.Err(); err != nil {
+			p.err = err
+			return false
+		}
+		res, err := GetPage[T](p.ctx, p.client, *p.nextURL)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		p.page = res.Results
+		p.nextURL = res.Next
+		p.count = res.Count
+	}
+	p.item = p.page[0]
+	p.page = p.page[1:]
+	return true
+}
+
+func (p *Pager[T]) Item() T {
+	return p.item
+}
+
+// Count is the total number of entries reported by the endpoint, known
+// once the first page has been fetched.
+func (p *Pager[T]) Count() int {
+	return p.count
+}
+
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// ListAll collects every entry of a list endpoint.
+func ListAll[T any](ctx context.Context, c *Client, endpoint string) ([]T, error) {
+	items := []T{}
+	p := List[T](ctx, c, endpoint)
+	for p.Next() {
+		items = append(items, p.Item())
+	}
+	return items, p.Err()
+}
@@ -0,0 +1,123 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dudiko2/pokedexcli/internal/pokecache"
+)
+
+// offlineTransport fails every request, so tests only see cached data.
+type offlineTransport struct{}
+
+func (offlineTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, errors.New("network disabled in tests")
+}
+
+// newTestClient returns a client with an empty disk cache in a
+// temporary directory and no network.
+func newTestClient(t *testing.T) *Client {
+	disk, err := pokecache.NewDiskCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("creating disk cache: %v", err)
+	}
+	c := Client{
+		cache:   pokecache.NewCache(time.Minute),
+		disk:    disk,
+		limiter: newRateLimiter(0),
+		http:    &http.Client{Transport: offlineTransport{}},
+	}
+	return &c
+}
+
+func TestListFollowsNextLinks(t *testing.T) {
+	c := newTestClient(t)
+	second := ListURL("pokemon", 2, 2)
+	c.cache.Add(ListURL("pokemon", 0, defaultPageSize), []byte(`{
+		"count": 3,
+		"next": "`+second+`",
+		"results": [{"name": "bulbasaur"}, {"name": "ivysaur"}]
+	}`))
+	c.cache.Add(second, []byte(`{
+		"count": 3,
+		"next": null,
+		"results": [{"name": "venusaur"}]
+	}`))
+
+	names, err := ListAll[NameURLPair](context.Background(), c, "pokemon")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	expected := []string{"bulbasaur", "ivysaur", "venusaur"}
+	if len(names) != len(expected) {
+		t.Errorf("expected %v entries, got %v", len(expected), len(names))
+		return
+	}
+	for i, name := range expected {
+		if names[i].Name != name {
+			t.Errorf("expected %s at %v, got %s", name, i, names[i].Name)
+		}
+	}
+}
+
+func TestListStopsOnError(t *testing.T) {
+	c := newTestClient(t)
+	c.cache.Add(ListURL("pokemon", 0, defaultPageSize), []byte(`{
+		"count": 3,
+		"next": "https://example.com/pokemon?offset=2",
+		"results": [{"name": "bulbasaur"}]
+	}`))
+
+	p := List[NameURLPair](context.Background(), c, "pokemon")
+	seen := 0
+	for p.Next() {
+		seen++
+	}
+	if seen != 1 {
+		t.Errorf("expected 1 entry before the error, got %v", seen)
+		return
+	}
+	if p.Err() == nil {
+		t.Errorf("expected an error for a foreign next link")
+	}
+}
+
+func TestListCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	p := List[NameURLPair](ctx, newTestClient(t), "pokemon")
+	if p.Next() {
+		t.Errorf("expected no entries")
+		return
+	}
+	if !errors.Is(p.Err(), context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", p.Err())
+	}
+}
+
+func TestGetLocationAreas(t *testing.T) {
+	c := newTestClient(t)
+	c.cache.Add(ListURL("location-area", 0, 2), []byte(`{
+		"count": 3,
+		"next": "`+ListURL("location-area", 2, 2)+`",
+		"results": [{"name": "canalave-city-area"}, {"name": "eterna-city-area"}]
+	}`))
+
+	page, err := c.GetLocationAreas(context.Background(), ListURL("location-area", 0, 2))
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if len(page.Results) != 2 || page.Next == nil {
+		t.Errorf("expected 2 areas and a next link, got %v", page)
+		return
+	}
+	_, err = c.GetLocationAreas(context.Background(), ListURL("pokemon", 0, 2))
+	if err == nil {
+		t.Errorf("expected an error for a pokemon list url")
+	}
+}
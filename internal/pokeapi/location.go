@@ -3,34 +3,25 @@ package pokeapi
 import "fmt"
 
 type LocationRes struct {
-	GameIndex int             `json:"game_index"`
-	ID        int             `json:"id"`
-	Name      string          `json:"name"`
-	Names     []LocationNames `json:"names"`
-	Location  struct {
-		Name string `json:"name"`
-		URL  string `json:"url"`
-	} `json:"location"`
+	GameIndex            int                        `json:"game_index"`
+	ID                   int                        `json:"id"`
+	Name                 string                     `json:"name"`
+	Names                []LocationNames            `json:"names"`
+	Location             NameURLPair                `json:"location"`
 	PokemonEncounters    []LocationPokemonEncounter `json:"pokemon_encounters"`
 	EncounterMethodRates []EncounterMethodRate      `json:"encounter_method_rates"`
 }
 
 type EncounterMethodRate struct {
-	EncounterMethod struct {
-		Name string `json:"name"`
-		URL  string `json:"url"`
-	} `json:"encounter_method"`
-	VersionDetails []struct {
+	EncounterMethod NameURLPair `json:"encounter_method"`
+	VersionDetails  []struct {
 		Rate    int     `json:"rate"`
 		Version Version `json:"version"`
 	} `json:"version_details"`
 }
 
 type LocationPokemonEncounter struct {
-	Pokemon struct {
-		Name string `json:"name"`
-		URL  string `json:"url"`
-	} `json:"pokemon"`
+	Pokemon        NameURLPair                      `json:"pokemon"`
 	VersionDetails []PokemonEncounterVersionDetails `json:"version_details"`
 }
 
@@ -39,31 +30,36 @@ type LocationNames struct {
 	Name     string       `json:"name"`
 }
 
-type LanguageInfo struct {
-	Name string `json:"name"`
-	URL  string `json:"url"`
-}
+type LanguageInfo = NameURLPair
 
 type PokemonEncounterVersionDetails struct {
 	EncounterDetails []struct {
-		Chance          int   `json:"chance"`
-		ConditionValues []any `json:"condition_values"`
-		MaxLevel        int   `json:"max_level"`
-		Method          struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"method"`
-		MinLevel int `json:"min_level"`
+		Chance          int           `json:"chance"`
+		ConditionValues []NameURLPair `json:"condition_values"`
+		MaxLevel        int           `json:"max_level"`
+		Method          NameURLPair   `json:"method"`
+		MinLevel        int           `json:"min_level"`
 	} `json:"encounter_details"`
 	MaxChance int     `json:"max_chance"`
 	Version   Version `json:"version"`
 }
 
-type Version struct {
-	Name string `json:"name"`
-	URL  string `json:"url"`
+// LocationInfoRes is a location (a town, route or cave) as opposed to
+// LocationRes, which describes a single area within one.
+type LocationInfoRes struct {
+	ID          int             `json:"id"`
+	Name        string          `json:"name"`
+	Names       []LocationNames `json:"names"`
+	Region      NameURLPair     `json:"region"`
+	Areas       []NameURLPair   `json:"areas"`
+	GameIndices []struct {
+		GameIndex  int         `json:"game_index"`
+		Generation NameURLPair `json:"generation"`
+	} `json:"game_indices"`
 }
 
+type Version = NameURLPair
+
 func (c *Client) GetLocationArea(id string) (LocationRes, error) {
 	path := fmt.Sprintf("location-area/%s", id)
 	key := baseURL + path
@@ -77,3 +73,17 @@ func (c *Client) GetLocationArea(id string) (LocationRes, error) {
 	}
 	return location, nil
 }
+
+func (c *Client) GetLocation(id string) (LocationInfoRes, error) {
+	path := fmt.Sprintf("location/%s", id)
+	key := baseURL + path
+	body, err := c.cachedGetData(key)
+	if err != nil {
+		return LocationInfoRes{}, err
+	}
+	location, err := parseJSON[LocationInfoRes](body)
+	if err != nil {
+		return LocationInfoRes{}, err
+	}
+	return location, nil
+}
@@ -1,35 +1,19 @@
 package pokeapi
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"strings"
+)
 
-type GetLocationAreasPayload struct {
-	Offset int
-	Limit  int
-}
-
-type LocationAreasEntry struct {
-	Name string  `json:"name"`
-	Url  *string `json:"url"`
-}
+type LocationAreasRes = ResourceList[NameURLPair]
 
-type LocationAreasRes struct {
-	Count    int                  `json:"count"`
-	Next     *string              `json:"next"`
-	Previous *string              `json:"previous"`
-	Results  []LocationAreasEntry `json:"results"`
-}
-
-func (c *Client) GetLocationAreas(p GetLocationAreasPayload) (LocationAreasRes,
-	error) {
-	path := fmt.Sprintf("location-area?offset=%v&limit=%v", p.Offset, p.Limit)
-	key := baseURL + path
-	body, err := c.cachedGetData(key)
-	if err != nil {
-		return LocationAreasRes{}, err
-	}
-	locations, err := parseJSON[LocationAreasRes](body)
-	if err != nil {
-		return LocationAreasRes{}, err
+// GetLocationAreas fetches a page of location areas by URL, as built by
+// ListURL("location-area", ...) or returned in a previous page's Next
+// and Previous links.
+func (c *Client) GetLocationAreas(ctx context.Context, pageURL string) (LocationAreasRes, error) {
+	if !strings.HasPrefix(pageURL, baseURL+"location-area?") {
+		return LocationAreasRes{}, fmt.Errorf("not a location area list url: %s", pageURL)
 	}
-	return locations, nil
+	return GetPage[NameURLPair](ctx, c, pageURL)
 }
@@ -0,0 +1,46 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var ErrNoMachine = errors.New("no machine teaches this move")
+
+type MachineRes struct {
+	ID           int         `json:"id"`
+	Item         NameURLPair `json:"item"`
+	Move         NameURLPair `json:"move"`
+	VersionGroup NameURLPair `json:"version_group"`
+}
+
+func (c *Client) GetMachine(id string) (MachineRes, error) {
+	path := fmt.Sprintf("machine/%s", id)
+	key := baseURL + path
+	body, err := c.cachedGetData(key)
+	if err != nil {
+		return MachineRes{}, err
+	}
+	machine, err := parseJSON[MachineRes](body)
+	if err != nil {
+		return MachineRes{}, err
+	}
+	return machine, nil
+}
+
+// FindMachine returns the TM, HM or TR that teaches move in the given
+// version group, or ErrNoMachine if there is none.
+func (c *Client) FindMachine(move, versionGroup string) (MachineRes, error) {
+	m, err := c.GetMove(move)
+	if err != nil {
+		return MachineRes{}, err
+	}
+	for _, mm := range m.Machines {
+		if mm.VersionGroup.Name != versionGroup {
+			continue
+		}
+		return Resolve[MachineRes](context.Background(), c, mm.Machine)
+	}
+	return MachineRes{}, ErrNoMachine
+}
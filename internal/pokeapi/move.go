@@ -0,0 +1,40 @@
+package pokeapi
+
+import "fmt"
+
+type MoveRes struct {
+	ID                int             `json:"id"`
+	Name              string          `json:"name"`
+	Accuracy          *int            `json:"accuracy"`
+	Power             *int            `json:"power"`
+	PP                int             `json:"pp"`
+	Priority          int             `json:"priority"`
+	Type              NameURLPair     `json:"type"`
+	DamageClass       NameURLPair     `json:"damage_class"`
+	Generation        NameURLPair     `json:"generation"`
+	Names             []LocationNames `json:"names"`
+	FlavorTextEntries []FlavorText    `json:"flavor_text_entries"`
+	Machines          []MoveMachine   `json:"machines"`
+	LearnedByPokemon  []NameURLPair   `json:"learned_by_pokemon"`
+}
+
+// MoveMachine links a move to the machine that teaches it in one
+// version group. The machine itself is only referenced by URL.
+type MoveMachine struct {
+	Machine      APIResource `json:"machine"`
+	VersionGroup NameURLPair `json:"version_group"`
+}
+
+func (c *Client) GetMove(id string) (MoveRes, error) {
+	path := fmt.Sprintf("move/%s", id)
+	key := baseURL + path
+	body, err := c.cachedGetData(key)
+	if err != nil {
+		return MoveRes{}, err
+	}
+	move, err := parseJSON[MoveRes](body)
+	if err != nil {
+		return MoveRes{}, err
+	}
+	return move, nil
+}
@@ -0,0 +1,89 @@
+package pokeapi
+
+import "fmt"
+
+type NatureRes struct {
+	ID            int             `json:"id"`
+	Name          string          `json:"name"`
+	DecreasedStat *NameURLPair    `json:"decreased_stat"`
+	IncreasedStat *NameURLPair    `json:"increased_stat"`
+	HatesFlavor   *NameURLPair    `json:"hates_flavor"`
+	LikesFlavor   *NameURLPair    `json:"likes_flavor"`
+	Names         []LocationNames `json:"names"`
+}
+
+type CharacteristicRes struct {
+	ID             int         `json:"id"`
+	GeneModulo     int         `json:"gene_modulo"`
+	PossibleValues []int       `json:"possible_values"`
+	HighestStat    NameURLPair `json:"highest_stat"`
+	Descriptions   []struct {
+		Description string       `json:"description"`
+		Language    LanguageInfo `json:"language"`
+	} `json:"descriptions"`
+}
+
+type StatRes struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	GameIndex      int    `json:"game_index"`
+	IsBattleOnly   bool   `json:"is_battle_only"`
+	AffectingMoves struct {
+		Increase []StatAffectingMove `json:"increase"`
+		Decrease []StatAffectingMove `json:"decrease"`
+	} `json:"affecting_moves"`
+	AffectingNatures struct {
+		Increase []NameURLPair `json:"increase"`
+		Decrease []NameURLPair `json:"decrease"`
+	} `json:"affecting_natures"`
+	Characteristics []APIResource   `json:"characteristics"`
+	MoveDamageClass *NameURLPair    `json:"move_damage_class"`
+	Names           []LocationNames `json:"names"`
+}
+
+type StatAffectingMove struct {
+	Change int         `json:"change"`
+	Move   NameURLPair `json:"move"`
+}
+
+func (c *Client) GetNature(id string) (NatureRes, error) {
+	path := fmt.Sprintf("nature/%s", id)
+	key := baseURL + path
+	body, err := c.cachedGetData(key)
+	if err != nil {
+		return NatureRes{}, err
+	}
+	nature, err := parseJSON[NatureRes](body)
+	if err != nil {
+		return NatureRes{}, err
+	}
+	return nature, nil
+}
+
+func (c *Client) GetCharacteristic(id string) (CharacteristicRes, error) {
+	path := fmt.Sprintf("characteristic/%s", id)
+	key := baseURL + path
+	body, err := c.cachedGetData(key)
+	if err != nil {
+		return CharacteristicRes{}, err
+	}
+	characteristic, err := parseJSON[CharacteristicRes](body)
+	if err != nil {
+		return CharacteristicRes{}, err
+	}
+	return characteristic, nil
+}
+
+func (c *Client) GetStat(id string) (StatRes, error) {
+	path := fmt.Sprintf("stat/%s", id)
+	key := baseURL + path
+	body, err := c.cachedGetData(key)
+	if err != nil {
+		return StatRes{}, err
+	}
+	stat, err := parseJSON[StatRes](body)
+	if err != nil {
+		return StatRes{}, err
+	}
+	return stat, nil
+}
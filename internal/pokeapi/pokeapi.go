@@ -1,11 +1,14 @@
 package pokeapi
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/dudiko2/pokedexcli/internal/pokecache"
@@ -13,27 +16,72 @@ import (
 
 const baseURL = "https://pokeapi.co/api/v2/"
 
+const requestsPerSecond = 10
+
+var ErrNotFound = errors.New("not found")
+
+// StatusError is returned for non-2xx responses. A 404 matches
+// ErrNotFound with errors.Is.
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("request failed (code %v)", e.StatusCode)
+}
+
+func (e *StatusError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
 type Client struct {
-	cache pokecache.Cache
+	cache   *pokecache.Cache
+	disk    *pokecache.DiskCache
+	limiter *rateLimiter
+	http    *http.Client
 }
 
-func NewClient() *Client {
+// NewClient returns a client that keeps responses on disk in cacheDir.
+// With an empty cacheDir, or one that can't be created, the client still
+// works, it just won't remember anything between runs.
+func NewClient(cacheDir string) *Client {
 	c := Client{
-		cache: *pokecache.NewCache(5 * time.Minute),
+		cache:   pokecache.NewCache(5 * time.Minute),
+		disk:    openDiskCache(cacheDir),
+		limiter: newRateLimiter(time.Second / requestsPerSecond),
+		http:    http.DefaultClient,
 	}
 	return &c
 }
 
-func getData(urlString string) (body []byte, err error) {
-	res, err := http.Get(urlString)
+// Persistent reports whether responses are kept on disk between runs.
+func (c *Client) Persistent() bool {
+	return c.disk != nil
+}
+
+func openDiskCache(dir string) *pokecache.DiskCache {
+	if dir == "" {
+		return nil
+	}
+	disk, err := pokecache.NewDiskCache(dir)
+	if err != nil {
+		return nil
+	}
+	return disk
+}
+
+func getData(ctx context.Context, client *http.Client, urlString string) (body []byte, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, urlString, nil)
+	if err != nil {
+		return body, err
+	}
+	res, err := client.Do(req)
 	if err != nil {
 		return body, err
 	}
 	defer res.Body.Close()
-	// XXX add custom errors
 	if res.StatusCode > 299 {
-		errMsg := fmt.Sprintf("request failed (code %v)", res.StatusCode)
-		return body, errors.New(errMsg)
+		return body, &StatusError{StatusCode: res.StatusCode}
 	}
 	body, readErr := io.ReadAll(res.Body)
 	if readErr != nil {
@@ -43,18 +91,89 @@ func getData(urlString string) (body []byte, err error) {
 }
 
 func (c *Client) cachedGetData(key string) ([]byte, error) {
+	return c.cachedGetDataContext(context.Background(), key)
+}
+
+// cacheKey is the key a URL is cached under. Resources are linked both
+// with and without a trailing slash; both share one entry.
+func cacheKey(url string) string {
+	return strings.TrimSuffix(url, "/")
+}
+
+// aliasKey returns the other key a resource can be looked up by: its
+// name when it was fetched by ID, and its ID when fetched by name. Lists,
+// sub-resources and responses without both an id and a name have none.
+func aliasKey(key string, data []byte) (string, bool) {
+	path, ok := strings.CutPrefix(key, baseURL)
+	if !ok || strings.ContainsAny(path, "?#") {
+		return "", false
+	}
+	endpoint, id, ok := strings.Cut(path, "/")
+	if !ok || id == "" || strings.Contains(id, "/") {
+		return "", false
+	}
+	var ident struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(data, &ident); err != nil || ident.ID < 1 || ident.Name == "" {
+		return "", false
+	}
+	switch id {
+	case ident.Name:
+		return baseURL + endpoint + "/" + strconv.Itoa(ident.ID), true
+	case strconv.Itoa(ident.ID):
+		return baseURL + endpoint + "/" + ident.Name, true
+	}
+	return "", false
+}
+
+func (c *Client) store(key string, data []byte) {
+	c.cache.Add(key, data)
+	if c.disk != nil {
+		// a failed write only costs a refetch next run
+		_ = c.disk.Add(key, data)
+	}
+}
+
+// cachedGetDataContext serves url from memory, then disk, then the
+// network. Fetched resources are stored under both their ID and name,
+// so commands hit the cache whichever one they are given.
+func (c *Client) cachedGetDataContext(ctx context.Context, url string) ([]byte, error) {
+	key := cacheKey(url)
 	d, found := c.cache.Get(key)
 	if found {
 		return d, nil
 	}
-	d, err := getData(key)
+	if c.disk != nil {
+		d, found = c.disk.Get(key)
+		if found {
+			c.cache.Add(key, d)
+			return d, nil
+		}
+	}
+	if err := c.limiter.Wait(ctx); err != nil {
+		return []byte{}, err
+	}
+	d, err := getData(ctx, c.http, url)
 	if err != nil {
 		return []byte{}, err
 	}
-	c.cache.Add(key, d)
+	c.store(key, d)
+	if alias, ok := aliasKey(key, d); ok {
+		c.store(alias, d)
+	}
 	return d, nil
 }
 
+// Warm fetches path into the memory and disk caches without decoding
+// it, so later Get calls for the same resource, by ID or by name, are
+// served locally.
+func (c *Client) Warm(ctx context.Context, path string) error {
+	_, err := c.cachedGetDataContext(ctx, baseURL+path)
+	return err
+}
+
 func parseJSON[T any](data []byte) (T, error) {
 	var parsed T
 	err := json.Unmarshal(data, &parsed)
@@ -0,0 +1,112 @@
+package pokeapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dudiko2/pokedexcli/internal/pokecache"
+)
+
+// fixtureTransport serves fixed bodies by URL and counts the requests.
+type fixtureTransport struct {
+	bodies   map[string]string
+	requests int
+}
+
+func (f *fixtureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.requests++
+	body, ok := f.bodies[req.URL.String()]
+	status := http.StatusOK
+	if !ok {
+		status = http.StatusNotFound
+	}
+	res := http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+	return &res, nil
+}
+
+func TestAliasKey(t *testing.T) {
+	pikachu := []byte(`{"id": 25, "name": "pikachu"}`)
+	cases := []struct {
+		key      string
+		data     []byte
+		expected string
+	}{
+		{key: baseURL + "pokemon/25", data: pikachu, expected: baseURL + "pokemon/pikachu"},
+		{key: baseURL + "pokemon/pikachu", data: pikachu, expected: baseURL + "pokemon/25"},
+		{key: baseURL + "pokemon/pikachu/encounters", data: pikachu, expected: ""},
+		{key: baseURL + "pokemon?offset=0&limit=20", data: pikachu, expected: ""},
+		{key: baseURL + "machine/1", data: []byte(`{"id": 1}`), expected: ""},
+		{key: "https://example.com/pokemon/25", data: pikachu, expected: ""},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			actual, _ := aliasKey(c.key, c.data)
+			if actual != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, actual)
+				return
+			}
+		})
+	}
+}
+
+func TestWarmServesByIDAndName(t *testing.T) {
+	c := newTestClient(t)
+	transport := &fixtureTransport{bodies: map[string]string{
+		baseURL + "pokemon/25": `{"id": 25, "name": "pikachu", "base_experience": 112}`,
+	}}
+	c.http = &http.Client{Transport: transport}
+
+	if err := c.Warm(context.Background(), "pokemon/25"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, id := range []string{"pikachu", "25"} {
+		p, err := c.GetPokemonData(id)
+		if err != nil || p.Name != "pikachu" {
+			t.Errorf("expected pikachu for %s, got %q (%v)", id, p.Name, err)
+			return
+		}
+	}
+	if transport.requests != 1 {
+		t.Errorf("expected 1 request, got %v", transport.requests)
+		return
+	}
+
+	// a later run only has the disk cache and no network
+	offline := *c
+	offline.cache = pokecache.NewCache(time.Minute)
+	offline.http = &http.Client{Transport: offlineTransport{}}
+	p, err := offline.GetPokemonData("pikachu")
+	if err != nil || p.Name != "pikachu" {
+		t.Errorf("expected pikachu from disk, got %q (%v)", p.Name, err)
+		return
+	}
+}
+
+func TestNewClientUsesCacheDir(t *testing.T) {
+	dir := t.TempDir()
+	c := NewClient(dir)
+	c.http = &http.Client{Transport: &fixtureTransport{bodies: map[string]string{
+		baseURL + "region/1": `{"id": 1, "name": "kanto"}`,
+	}}}
+	if _, err := c.GetRegion("1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	disk, err := pokecache.NewDiskCache(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	keys, err := disk.Keys()
+	if err != nil || len(keys) != 2 {
+		t.Errorf("expected the region under its ID and name in %s, got %v (%v)", dir, keys, err)
+		return
+	}
+}
@@ -0,0 +1,32 @@
+package pokeapi
+
+import "fmt"
+
+type PokedexRes struct {
+	ID             int             `json:"id"`
+	Name           string          `json:"name"`
+	IsMainSeries   bool            `json:"is_main_series"`
+	Names          []LocationNames `json:"names"`
+	Region         *NameURLPair    `json:"region"`
+	VersionGroups  []NameURLPair   `json:"version_groups"`
+	PokemonEntries []PokedexEntry  `json:"pokemon_entries"`
+}
+
+type PokedexEntry struct {
+	EntryNumber    int         `json:"entry_number"`
+	PokemonSpecies NameURLPair `json:"pokemon_species"`
+}
+
+func (c *Client) GetPokedex(id string) (PokedexRes, error) {
+	path := fmt.Sprintf("pokedex/%s", id)
+	key := baseURL + path
+	body, err := c.cachedGetData(key)
+	if err != nil {
+		return PokedexRes{}, err
+	}
+	pokedex, err := parseJSON[PokedexRes](body)
+	if err != nil {
+		return PokedexRes{}, err
+	}
+	return pokedex, nil
+}
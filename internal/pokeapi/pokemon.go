@@ -256,3 +256,22 @@ func (c *Client) GetPokemonData(id string) (PokemonRes, error) {
 	}
 	return pokemon, nil
 }
+
+type PokemonEncounterRes struct {
+	LocationArea   NameURLPair                      `json:"location_area"`
+	VersionDetails []PokemonEncounterVersionDetails `json:"version_details"`
+}
+
+func (c *Client) GetPokemonEncounters(id string) ([]PokemonEncounterRes, error) {
+	path := fmt.Sprintf("pokemon/%s/encounters", id)
+	key := baseURL + path
+	body, err := c.cachedGetData(key)
+	if err != nil {
+		return nil, err
+	}
+	encounters, err := parseJSON[[]PokemonEncounterRes](body)
+	if err != nil {
+		return nil, err
+	}
+	return encounters, nil
+}
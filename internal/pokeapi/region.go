@@ -0,0 +1,27 @@
+package pokeapi
+
+import "fmt"
+
+type RegionRes struct {
+	ID             int             `json:"id"`
+	Name           string          `json:"name"`
+	Names          []LocationNames `json:"names"`
+	Locations      []NameURLPair   `json:"locations"`
+	MainGeneration NameURLPair     `json:"main_generation"`
+	Pokedexes      []NameURLPair   `json:"pokedexes"`
+	VersionGroups  []NameURLPair   `json:"version_groups"`
+}
+
+func (c *Client) GetRegion(id string) (RegionRes, error) {
+	path := fmt.Sprintf("region/%s", id)
+	key := baseURL + path
+	body, err := c.cachedGetData(key)
+	if err != nil {
+		return RegionRes{}, err
+	}
+	region, err := parseJSON[RegionRes](body)
+	if err != nil {
+		return RegionRes{}, err
+	}
+	return region, nil
+}
@@ -0,0 +1,31 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrEmptyRef = errors.New("reference has no url")
+
+// Resolve follows a link, named or not, to the resource it points at
+// and decodes it as T, going through the client's cache like every
+// other request. T must match the kind of resource behind the link, e.g.
+//
+//	species, err := Resolve[PokemonSpeciesRes](ctx, c, pokemon.Species)
+func Resolve[T any](ctx context.Context, c *Client, ref Ref) (T, error) {
+	var zero T
+	url := ref.ResourceURL()
+	if url == "" {
+		return zero, ErrEmptyRef
+	}
+	if !strings.HasPrefix(url, baseURL) {
+		return zero, fmt.Errorf("not a PokeAPI url: %s", url)
+	}
+	body, err := c.cachedGetDataContext(ctx, url)
+	if err != nil {
+		return zero, err
+	}
+	return parseJSON[T](body)
+}
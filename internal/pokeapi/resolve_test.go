@@ -0,0 +1,39 @@
+package pokeapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	client := newTestClient(t)
+	client.http = &http.Client{Transport: &fixtureTransport{bodies: map[string]string{
+		baseURL + "machine/1/": `{"id": 1, "item": {"name": "tm01"}, "move": {"name": "mega-punch"}}`,
+	}}}
+	cases := []struct {
+		ref      Ref
+		expected string
+		fails    bool
+	}{
+		{ref: APIResource{URL: baseURL + "machine/1/"}, expected: "tm01"},
+		{ref: NameURLPair{Name: "1", URL: baseURL + "machine/1/"}, expected: "tm01"},
+		{ref: APIResource{}, fails: true},
+		{ref: APIResource{URL: "https://example.com/machine/1/"}, fails: true},
+		{ref: APIResource{URL: baseURL + "machine/2/"}, fails: true},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			m, err := Resolve[MachineRes](context.Background(), client, c.ref)
+			if (err != nil) != c.fails {
+				t.Errorf("expected failure %v, got %v", c.fails, err)
+				return
+			}
+			if m.Item.Name != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, m.Item.Name)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package pokeapi
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	snapshotVersion      = 1
+	snapshotManifestName = "manifest.json"
+)
+
+var ErrNoDiskCache = errors.New("disk cache is not available")
+
+// SnapshotManifest is stored first in every snapshot archive and lists
+// the cached responses that follow it.
+type SnapshotManifest struct {
+	Version   int             `json:"version"`
+	CreatedAt time.Time       `json:"created_at"`
+	BaseURL   string          `json:"base_url"`
+	Entries   []SnapshotEntry `json:"entries"`
+}
+
+type SnapshotEntry struct {
+	Key    string `json:"key"`
+	File   string `json:"file"`
+	Size   int    `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// ExportSnapshot writes every response in the disk cache to w as a
+// gzipped tar archive and returns the number of entries written.
+func (c *Client) ExportSnapshot(w io.Writer) (int, error) {
+	if c.disk == nil {
+		return 0, ErrNoDiskCache
+	}
+	keys, err := c.disk.Keys()
+	if err != nil {
+		return 0, err
+	}
+	manifest := SnapshotManifest{
+		Version:   snapshotVersion,
+		CreatedAt: time.Now().UTC(),
+		BaseURL:   baseURL,
+	}
+	bodies := [][]byte{}
+	for _, key := range keys {
+		if !strings.HasPrefix(key, baseURL) {
+			continue
+		}
+		d, found := c.disk.Get(key)
+		if !found {
+			continue
+		}
+		manifest.Entries = append(manifest.Entries, SnapshotEntry{
+			Key:    key,
+			File:   fmt.Sprintf("data/%06d.json", len(bodies)),
+			Size:   len(d),
+			SHA256: checksum(d),
+		})
+		bodies = append(bodies, d)
+	}
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	err = writeTarFile(tw, snapshotManifestName, manifestData, manifest.CreatedAt)
+	for i := 0; err == nil && i < len(bodies); i++ {
+		err = writeTarFile(tw, manifest.Entries[i].File, bodies[i], manifest.CreatedAt)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if err := tw.Close(); err != nil {
+		return 0, err
+	}
+	if err := gz.Close(); err != nil {
+		return 0, err
+	}
+	return len(bodies), nil
+}
+
+func writeTarFile(tw *tar.Writer, name string, data []byte, modTime time.Time) error {
+	hdr := tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	}
+	if err := tw.WriteHeader(&hdr); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
+
+// ImportSnapshot loads an archive written by ExportSnapshot into the
+// disk cache and returns the number of entries imported. Entries are
+// checked against the manifest before anything is written.
+func (c *Client) ImportSnapshot(r io.Reader) (int, error) {
+	if c.disk == nil {
+		return 0, ErrNoDiskCache
+	}
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return 0, err
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+
+	hdr, err := tr.Next()
+	if err != nil {
+		return 0, fmt.Errorf("reading snapshot: %w", err)
+	}
+	if hdr.Name != snapshotManifestName {
+		return 0, errors.New("snapshot is missing its manifest")
+	}
+	manifestData, err := io.ReadAll(tr)
+	if err != nil {
+		return 0, err
+	}
+	manifest, err := parseJSON[SnapshotManifest](manifestData)
+	if err != nil {
+		return 0, fmt.Errorf("invalid snapshot manifest: %w", err)
+	}
+	if manifest.Version != snapshotVersion {
+		return 0, fmt.Errorf("unsupported snapshot version %v", manifest.Version)
+	}
+	byFile := map[string]SnapshotEntry{}
+	for _, e := range manifest.Entries {
+		if !strings.HasPrefix(e.Key, baseURL) {
+			return 0, fmt.Errorf("snapshot entry %s is not a PokeAPI url", e.Key)
+		}
+		byFile[e.File] = e
+	}
+
+	bodies := map[string][]byte{}
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("reading snapshot: %w", err)
+		}
+		e, ok := byFile[hdr.Name]
+		if !ok {
+			return 0, fmt.Errorf("snapshot file %s is not in the manifest", hdr.Name)
+		}
+		d, err := io.ReadAll(tr)
+		if err != nil {
+			return 0, err
+		}
+		if len(d) != e.Size || checksum(d) != e.SHA256 {
+			return 0, fmt.Errorf("snapshot file %s is corrupted", hdr.Name)
+		}
+		bodies[e.Key] = d
+	}
+	if len(bodies) != len(manifest.Entries) {
+		return 0, fmt.Errorf("snapshot is missing %v entries", len(manifest.Entries)-len(bodies))
+	}
+	for key, d := range bodies {
+		if err := c.disk.Add(key, d); err != nil {
+			return 0, err
+		}
+	}
+	return len(bodies), nil
+}
@@ -0,0 +1,57 @@
+package pokeapi
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	src := newTestClient(t)
+	entries := map[string]string{
+		baseURL + "pokemon/pikachu": `{"name": "pikachu"}`,
+		baseURL + "location-area/1": `{"name": "canalave-city-area"}`,
+	}
+	for key, val := range entries {
+		src.disk.Add(key, []byte(val))
+	}
+
+	var buf bytes.Buffer
+	n, err := src.ExportSnapshot(&buf)
+	if err != nil {
+		t.Errorf("unexpected export error: %v", err)
+		return
+	}
+	if n != len(entries) {
+		t.Errorf("expected %v exported entries, got %v", len(entries), n)
+		return
+	}
+
+	dst := newTestClient(t)
+	n, err = dst.ImportSnapshot(&buf)
+	if err != nil {
+		t.Errorf("unexpected import error: %v", err)
+		return
+	}
+	if n != len(entries) {
+		t.Errorf("expected %v imported entries, got %v", len(entries), n)
+		return
+	}
+	for key, val := range entries {
+		d, err := dst.cachedGetData(key)
+		if err != nil {
+			t.Errorf("expected %s to be served from the cache: %v", key, err)
+			continue
+		}
+		if string(d) != val {
+			t.Errorf("expected %s, got %s", val, d)
+		}
+	}
+}
+
+func TestSnapshotRejectsGarbage(t *testing.T) {
+	c := newTestClient(t)
+	_, err := c.ImportSnapshot(bytes.NewReader([]byte("not a snapshot")))
+	if err == nil {
+		t.Errorf("expected an error")
+	}
+}
@@ -0,0 +1,49 @@
+package pokeapi
+
+import "fmt"
+
+type PokemonSpeciesRes struct {
+	ID                 int             `json:"id"`
+	Name               string          `json:"name"`
+	Order              int             `json:"order"`
+	GenderRate         int             `json:"gender_rate"`
+	CaptureRate        int             `json:"capture_rate"`
+	BaseHappiness      int             `json:"base_happiness"`
+	IsBaby             bool            `json:"is_baby"`
+	IsLegendary        bool            `json:"is_legendary"`
+	IsMythical         bool            `json:"is_mythical"`
+	HatchCounter       int             `json:"hatch_counter"`
+	GrowthRate         NameURLPair     `json:"growth_rate"`
+	EggGroups          []NameURLPair   `json:"egg_groups"`
+	EvolvesFromSpecies *NameURLPair    `json:"evolves_from_species"`
+	Generation         NameURLPair     `json:"generation"`
+	Names              []LocationNames `json:"names"`
+	FlavorTextEntries  []FlavorText    `json:"flavor_text_entries"`
+	Genera             []struct {
+		Genus    string       `json:"genus"`
+		Language LanguageInfo `json:"language"`
+	} `json:"genera"`
+	PokedexNumbers []struct {
+		EntryNumber int         `json:"entry_number"`
+		Pokedex     NameURLPair `json:"pokedex"`
+	} `json:"pokedex_numbers"`
+	Varieties []struct {
+		IsDefault bool        `json:"is_default"`
+		Pokemon   NameURLPair `json:"pokemon"`
+	} `json:"varieties"`
+	EvolutionChain APIResource `json:"evolution_chain"`
+}
+
+func (c *Client) GetPokemonSpecies(id string) (PokemonSpeciesRes, error) {
+	path := fmt.Sprintf("pokemon-species/%s", id)
+	key := baseURL + path
+	body, err := c.cachedGetData(key)
+	if err != nil {
+		return PokemonSpeciesRes{}, err
+	}
+	species, err := parseJSON[PokemonSpeciesRes](body)
+	if err != nil {
+		return PokemonSpeciesRes{}, err
+	}
+	return species, nil
+}
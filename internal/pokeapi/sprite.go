@@ -0,0 +1,15 @@
+package pokeapi
+
+import "errors"
+
+var ErrNoSprite = errors.New("no sprite")
+
+// GetSprite downloads the image at url, one of the PokemonRes.Sprites
+// URLs. Images are cached like any other response, but snapshots only
+// hold API data so they are left out of exports.
+func (c *Client) GetSprite(url string) ([]byte, error) {
+	if url == "" {
+		return nil, ErrNoSprite
+	}
+	return c.cachedGetData(url)
+}
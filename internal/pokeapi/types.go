@@ -4,3 +4,22 @@ type NameURLPair struct {
 	Name string `json:"name"`
 	URL  string `json:"url"`
 }
+
+// Ref is a link to another resource that Resolve can follow.
+type Ref interface {
+	ResourceURL() string
+}
+
+func (p NameURLPair) ResourceURL() string {
+	return p.URL
+}
+
+// APIResource is a link to a resource that has no name, such as a
+// machine or an evolution chain.
+type APIResource struct {
+	URL string `json:"url"`
+}
+
+func (r APIResource) ResourceURL() string {
+	return r.URL
+}
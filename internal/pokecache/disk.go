@@ -0,0 +1,78 @@
+package pokecache
+
+import (
+	"errors"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DiskCache keeps responses as files in a directory so they survive
+// restarts. Entries never expire: PokeAPI resources don't change.
+type DiskCache struct {
+	dir string
+}
+
+func NewDiskCache(dir string) (*DiskCache, error) {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, err
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+// Filenames are the escaped keys, so the keys can be recovered by
+// listing the directory.
+func (d *DiskCache) path(key string) string {
+	return filepath.Join(d.dir, url.PathEscape(key))
+}
+
+func (d *DiskCache) Add(key string, data []byte) error {
+	tmp, err := os.CreateTemp(d.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	closeErr := tmp.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), d.path(key))
+}
+
+func (d *DiskCache) Get(key string) (data []byte, found bool) {
+	data, err := os.ReadFile(d.path(key))
+	if err != nil {
+		return []byte{}, false
+	}
+	return data, true
+}
+
+// Keys lists every key stored in the cache.
+func (d *DiskCache) Keys() ([]string, error) {
+	entries, err := os.ReadDir(d.dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	keys := []string{}
+	for _, e := range entries {
+		if !e.Type().IsRegular() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		key, err := url.PathUnescape(e.Name())
+		if err != nil || url.PathEscape(key) != e.Name() {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
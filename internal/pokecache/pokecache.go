@@ -48,8 +48,16 @@ func (c *Cache) Delete(key string) {
 	delete(c.table, key)
 }
 
+// ForEachEntry calls cb on a snapshot of the cache, so cb may safely
+// add or delete entries.
 func (c *Cache) ForEachEntry(cb func(key string, ent cacheEntry)) {
+	c.mu.Lock()
+	snapshot := make(map[string]cacheEntry, len(c.table))
 	for k, v := range c.table {
+		snapshot[k] = v
+	}
+	c.mu.Unlock()
+	for k, v := range snapshot {
 		cb(k, v)
 	}
 }
@@ -59,3 +59,26 @@ func TestReapLoop(t *testing.T) {
 		return
 	}
 }
+
+func TestDiskAddGet(t *testing.T) {
+	cache, err := NewDiskCache(t.TempDir())
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	key := "https://example.com/path?offset=0&limit=20"
+	err = cache.Add(key, []byte("testdata"))
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	val, ok := cache.Get(key)
+	if !ok || string(val) != "testdata" {
+		t.Errorf("expected to find value")
+		return
+	}
+	keys, err := cache.Keys()
+	if err != nil || len(keys) != 1 || keys[0] != key {
+		t.Errorf("expected keys to be [%s], got %v (%v)", key, keys, err)
+	}
+}
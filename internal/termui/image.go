@@ -0,0 +1,150 @@
+package termui
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"strings"
+)
+
+// asciiRamp goes from the lightest to the darkest character.
+const asciiRamp = ".:-=+*#%@"
+
+// RGB picks a 24-bit foreground color.
+func RGB(r, g, b uint8) Color {
+	return Color(fmt.Sprintf("38;2;%d;%d;%d", r, g, b))
+}
+
+// BgRGB picks a 24-bit background color.
+func BgRGB(r, g, b uint8) Color {
+	return Color(fmt.Sprintf("48;2;%d;%d;%d", r, g, b))
+}
+
+// Bg256 picks a background color from the 256 color palette.
+func Bg256(n int) Color {
+	return Color(fmt.Sprintf("48;5;%d", n))
+}
+
+// to256 maps a color to the 6x6x6 cube of the 256 color palette.
+func to256(c color.NRGBA) int {
+	level := func(v uint8) int {
+		return (int(v)*5 + 127) / 255
+	}
+	return 16 + 36*level(c.R) + 6*level(c.G) + level(c.B)
+}
+
+func visible(c color.NRGBA) bool {
+	return c.A >= 0x80
+}
+
+// Image draws img with two pixels per character cell: the upper half
+// block is colored with the top pixel and its background with the
+// bottom one. Without colors it falls back to ASCII shading. Transparent
+// borders are cropped and images wider than the terminal are scaled
+// down.
+func (t Terminal) Image(img image.Image) string {
+	pixels := crop(img)
+	if width := t.Width(); width > 0 && len(pixels) > 0 && len(pixels[0]) > width {
+		pixels = scale(pixels, width)
+	}
+	var sb strings.Builder
+	for y := 0; y < len(pixels); y += 2 {
+		line := ""
+		for x := range pixels[y] {
+			top := pixels[y][x]
+			bottom := color.NRGBA{}
+			if y+1 < len(pixels) {
+				bottom = pixels[y+1][x]
+			}
+			line += t.cell(top, bottom)
+		}
+		sb.WriteString(strings.TrimRight(line, " ") + "\n")
+	}
+	return sb.String()
+}
+
+func (t Terminal) fg(c color.NRGBA) Color {
+	if t.TrueColor {
+		return RGB(c.R, c.G, c.B)
+	}
+	return Color256(to256(c))
+}
+
+func (t Terminal) bg(c color.NRGBA) Color {
+	if t.TrueColor {
+		return BgRGB(c.R, c.G, c.B)
+	}
+	return Bg256(to256(c))
+}
+
+func (t Terminal) cell(top, bottom color.NRGBA) string {
+	if !t.Color {
+		return asciiCell(top, bottom)
+	}
+	switch {
+	case visible(top) && visible(bottom):
+		return t.Paint("▀", t.fg(top), t.bg(bottom))
+	case visible(top):
+		return t.Paint("▀", t.fg(top))
+	case visible(bottom):
+		return t.Paint("▄", t.fg(bottom))
+	}
+	return " "
+}
+
+// asciiCell shades a cell by the average brightness of its visible
+// pixels, darker pixels getting denser characters.
+func asciiCell(top, bottom color.NRGBA) string {
+	total, count := 0, 0
+	for _, c := range []color.NRGBA{top, bottom} {
+		if visible(c) {
+			total += (299*int(c.R) + 587*int(c.G) + 114*int(c.B)) / 1000
+			count++
+		}
+	}
+	if count == 0 {
+		return " "
+	}
+	brightness := total / count
+	i := (255 - brightness) * len(asciiRamp) / 256
+	return string(asciiRamp[i])
+}
+
+// crop returns the pixels of img inside the smallest rectangle holding
+// every visible pixel.
+func crop(img image.Image) [][]color.NRGBA {
+	b := img.Bounds()
+	minX, minY, maxX, maxY := b.Max.X, b.Max.Y, b.Min.X-1, b.Min.Y-1
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if visible(color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)) {
+				minX, minY = min(minX, x), min(minY, y)
+				maxX, maxY = max(maxX, x), max(maxY, y)
+			}
+		}
+	}
+	pixels := [][]color.NRGBA{}
+	for y := minY; y <= maxY; y++ {
+		row := make([]color.NRGBA, 0, maxX-minX+1)
+		for x := minX; x <= maxX; x++ {
+			row = append(row, color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA))
+		}
+		pixels = append(pixels, row)
+	}
+	return pixels
+}
+
+// scale shrinks pixels to width columns by nearest neighbor, keeping
+// the aspect ratio.
+func scale(pixels [][]color.NRGBA, width int) [][]color.NRGBA {
+	oldWidth, oldHeight := len(pixels[0]), len(pixels)
+	height := max(1, oldHeight*width/oldWidth)
+	scaled := make([][]color.NRGBA, height)
+	for y := range scaled {
+		scaled[y] = make([]color.NRGBA, width)
+		for x := range scaled[y] {
+			scaled[y][x] = pixels[y*oldHeight/height][x*oldWidth/width]
+		}
+	}
+	return scaled
+}
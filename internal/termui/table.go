@@ -0,0 +1,138 @@
+package termui
+
+import (
+	"strings"
+)
+
+// minColumnWidth is as narrow as a column gets when a table is shrunk
+// to fit the terminal.
+const minColumnWidth = 4
+
+// Table is rows of cells under a header. Cells may be painted.
+type Table struct {
+	Header []string
+	Rows   [][]string
+	// Right lists the columns to right align, such as numbers.
+	Right []int
+}
+
+func (tab Table) columns() int {
+	n := len(tab.Header)
+	for _, r := range tab.Rows {
+		n = max(n, len(r))
+	}
+	return n
+}
+
+func (tab Table) rightAligned(col int) bool {
+	for _, c := range tab.Right {
+		if c == col {
+			return true
+		}
+	}
+	return false
+}
+
+// columnWidths sizes each column to its widest cell, then narrows the
+// widest columns until the table fits in width.
+func (tab Table) columnWidths(width, overhead int) []int {
+	widths := make([]int, tab.columns())
+	for _, row := range append([][]string{tab.Header}, tab.Rows...) {
+		for i, cell := range row {
+			widths[i] = max(widths[i], VisibleWidth(cell))
+		}
+	}
+	if width < 1 {
+		return widths
+	}
+	for {
+		total := overhead
+		widest := 0
+		for i, w := range widths {
+			total += w
+			if w > widths[widest] {
+				widest = i
+			}
+		}
+		if total <= width || widths[widest] <= minColumnWidth {
+			return widths
+		}
+		widths[widest]--
+	}
+}
+
+func pad(cell string, width int, right bool) string {
+	cell = Truncate(cell, width)
+	fill := strings.Repeat(" ", width-VisibleWidth(cell))
+	if right {
+		return fill + cell
+	}
+	return cell + fill
+}
+
+// Render draws tab with borders on a terminal, or as plain columns
+// separated by two spaces otherwise. The result ends in a newline.
+func (t Terminal) Render(tab Table) string {
+	n := tab.columns()
+	if n < 1 {
+		return ""
+	}
+	if !t.Boxed {
+		return t.renderPlain(tab, n)
+	}
+	widths := tab.columnWidths(t.Width(), 3*n+1)
+	line := func(left, mid, right string) string {
+		parts := make([]string, 0, n)
+		for _, w := range widths {
+			parts = append(parts, strings.Repeat("─", w+2))
+		}
+		return left + strings.Join(parts, mid) + right + "\n"
+	}
+	row := func(cells []string, header bool) string {
+		parts := make([]string, 0, n)
+		for i, w := range widths {
+			cell := ""
+			if i < len(cells) {
+				cell = cells[i]
+			}
+			cell = pad(cell, w, tab.rightAligned(i))
+			if header {
+				cell = t.Paint(cell, Bold)
+			}
+			parts = append(parts, " "+cell+" ")
+		}
+		return "│" + strings.Join(parts, "│") + "│\n"
+	}
+	var b strings.Builder
+	b.WriteString(line("┌", "┬", "┐"))
+	if len(tab.Header) > 0 {
+		b.WriteString(row(tab.Header, true))
+		b.WriteString(line("├", "┼", "┤"))
+	}
+	for _, r := range tab.Rows {
+		b.WriteString(row(r, false))
+	}
+	b.WriteString(line("└", "┴", "┘"))
+	return b.String()
+}
+
+func (t Terminal) renderPlain(tab Table, n int) string {
+	widths := tab.columnWidths(t.Width(), 2*(n-1))
+	var b strings.Builder
+	rows := tab.Rows
+	if len(tab.Header) > 0 {
+		rows = append([][]string{tab.Header}, rows...)
+	}
+	for _, r := range rows {
+		parts := make([]string, 0, n)
+		for i, w := range widths {
+			cell := ""
+			if i < len(r) {
+				cell = r[i]
+			}
+			parts = append(parts, pad(cell, w, tab.rightAligned(i)))
+		}
+		b.WriteString(strings.TrimRight(strings.Join(parts, "  "), " ") + "\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,148 @@
+// Package termui draws tables, bars and colored text for a terminal,
+// and degrades to plain text when output goes to a pipe or file.
+package termui
+
+import (
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// Color is an SGR parameter list, such as "31" for red.
+type Color string
+
+const (
+	Bold    Color = "1"
+	Dim     Color = "2"
+	Reverse Color = "7"
+	Red     Color = "31"
+	Green   Color = "32"
+	Yellow  Color = "33"
+	Blue    Color = "34"
+	Magenta Color = "35"
+	Cyan    Color = "36"
+	White   Color = "37"
+)
+
+// Color256 picks a color from the 256 color palette.
+func Color256(n int) Color {
+	return Color("38;5;" + strconv.Itoa(n))
+}
+
+// Terminal describes where output goes.
+type Terminal struct {
+	// Boxed draws tables with Unicode borders and bars with block
+	// characters. Otherwise tables are plain aligned columns.
+	Boxed bool
+	// Color enables ANSI colors.
+	Color bool
+	// TrueColor allows 24-bit colors where 256 colors would be used
+	// otherwise.
+	TrueColor bool
+	// Columns reports the current width. Nil, or a result below 1,
+	// means output may be as wide as it needs to be.
+	Columns func() int
+}
+
+// Detect inspects f. Borders, colors and width limits are only used on
+// a terminal, and colors are off when NO_COLOR is set or TERM is dumb.
+func Detect(f *os.File) Terminal {
+	fd := int(f.Fd())
+	if !term.IsTerminal(fd) {
+		return Terminal{}
+	}
+	t := Terminal{
+		Boxed:     true,
+		Color:     os.Getenv("NO_COLOR") == "" && os.Getenv("TERM") != "dumb",
+		TrueColor: os.Getenv("COLORTERM") == "truecolor" || os.Getenv("COLORTERM") == "24bit",
+		Columns: func() int {
+			width, _, err := term.GetSize(fd)
+			if err != nil {
+				return 0
+			}
+			return width
+		},
+	}
+	return t
+}
+
+// Width returns the width output should fit in, or 0 for no limit.
+func (t Terminal) Width() int {
+	if t.Columns == nil {
+		return 0
+	}
+	return max(0, t.Columns())
+}
+
+// Paint wraps s in the escape codes for colors, if colors are on.
+func (t Terminal) Paint(s string, colors ...Color) string {
+	if !t.Color || len(colors) < 1 || s == "" {
+		return s
+	}
+	codes := make([]string, 0, len(colors))
+	for _, c := range colors {
+		codes = append(codes, string(c))
+	}
+	return "\x1b[" + strings.Join(codes, ";") + "m" + s + "\x1b[0m"
+}
+
+var escapePattern = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// Strip removes color escape codes from s.
+func Strip(s string) string {
+	return escapePattern.ReplaceAllString(s, "")
+}
+
+// VisibleWidth is the number of columns s takes up on screen.
+func VisibleWidth(s string) int {
+	width := 0
+	for _, r := range Strip(s) {
+		width += RuneWidth(r)
+	}
+	return width
+}
+
+// PadRight pads s with spaces to width columns. Longer strings are
+// left as they are.
+func PadRight(s string, width int) string {
+	return s + strings.Repeat(" ", max(0, width-VisibleWidth(s)))
+}
+
+// Truncate shortens s to width columns, marking the cut with an
+// ellipsis. Colors are dropped from truncated strings.
+func Truncate(s string, width int) string {
+	if VisibleWidth(s) <= width {
+		return s
+	}
+	if width < 1 {
+		return ""
+	}
+	cut := []rune{}
+	used := 0
+	for _, r := range Strip(s) {
+		w := RuneWidth(r)
+		if used+w > width-1 {
+			break
+		}
+		cut = append(cut, r)
+		used += w
+	}
+	return string(cut) + "…"
+}
+
+// Bar draws a bar width columns wide, filled in proportion to value
+// out of total.
+func (t Terminal) Bar(value, total, width int, color Color) string {
+	if total < 1 || width < 1 {
+		return ""
+	}
+	filled := min(width, max(0, value*width/total))
+	full, empty := "#", "."
+	if t.Boxed {
+		full, empty = "█", "░"
+	}
+	return t.Paint(strings.Repeat(full, filled), color) + strings.Repeat(empty, width-filled)
+}
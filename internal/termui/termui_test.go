@@ -0,0 +1,225 @@
+package termui
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"testing"
+)
+
+func fixedWidth(width int) func() int {
+	return func() int {
+		return width
+	}
+}
+
+func TestRender(t *testing.T) {
+	tab := Table{
+		Header: []string{"Stat", "Value"},
+		Rows: [][]string{
+			{"hp", "35"},
+			{"special-attack", "50"},
+		},
+		Right: []int{1},
+	}
+	cases := []struct {
+		term     Terminal
+		expected string
+	}{
+		{
+			term: Terminal{},
+			expected: "Stat            Value\n" +
+				"hp                 35\n" +
+				"special-attack     50\n",
+		},
+		{
+			term: Terminal{Boxed: true},
+			expected: "┌────────────────┬───────┐\n" +
+				"│ Stat           │ Value │\n" +
+				"├────────────────┼───────┤\n" +
+				"│ hp             │    35 │\n" +
+				"│ special-attack │    50 │\n" +
+				"└────────────────┴───────┘\n",
+		},
+		{
+			term: Terminal{Boxed: true, Columns: fixedWidth(20)},
+			expected: "┌──────────┬───────┐\n" +
+				"│ Stat     │ Value │\n" +
+				"├──────────┼───────┤\n" +
+				"│ hp       │    35 │\n" +
+				"│ special… │    50 │\n" +
+				"└──────────┴───────┘\n",
+		},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			got := c.term.Render(tab)
+			if got != c.expected {
+				t.Errorf("expected\n%s\ngot\n%s", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestPaint(t *testing.T) {
+	plain := Terminal{}
+	if got := plain.Paint("fire", Red); got != "fire" {
+		t.Errorf("expected no color without Color, got %q", got)
+		return
+	}
+	color := Terminal{Color: true}
+	got := color.Paint("fire", Bold, Red)
+	if got != "\x1b[1;31mfire\x1b[0m" {
+		t.Errorf("unexpected escape codes %q", got)
+		return
+	}
+	if VisibleWidth(got) != 4 {
+		t.Errorf("expected visible width 4, got %v", VisibleWidth(got))
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	cases := []struct {
+		input    string
+		width    int
+		expected string
+	}{
+		{"pikachu", 10, "pikachu"},
+		{"pikachu", 7, "pikachu"},
+		{"pikachu", 5, "pika…"},
+		{"\x1b[31mpikachu\x1b[0m", 5, "pika…"},
+		{"pikachu", 0, ""},
+		{"ピカチュウ", 10, "ピカチュウ"},
+		{"ピカチュウ", 5, "ピカ…"},
+		{"ピカチュウ", 6, "ピカ…"},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			got := Truncate(c.input, c.width)
+			if got != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestBar(t *testing.T) {
+	cases := []struct {
+		term     Terminal
+		value    int
+		expected string
+	}{
+		{Terminal{}, 50, "#####....."},
+		{Terminal{Boxed: true}, 100, "██████████"},
+		{Terminal{}, 300, "##########"},
+		{Terminal{}, 0, ".........."},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			got := c.term.Bar(c.value, 100, 10, Green)
+			if got != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestImage(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	red := color.NRGBA{R: 255, A: 255}
+	black := color.NRGBA{A: 255}
+	img.SetNRGBA(1, 1, red)
+	img.SetNRGBA(2, 1, black)
+	img.SetNRGBA(2, 2, black)
+	cases := []struct {
+		term     Terminal
+		expected string
+	}{
+		{
+			term:     Terminal{},
+			expected: "#@\n",
+		},
+		{
+			term:     Terminal{Boxed: true, Color: true},
+			expected: "\x1b[38;5;196m▀\x1b[0m\x1b[38;5;16;48;5;16m▀\x1b[0m\n",
+		},
+		{
+			term:     Terminal{Boxed: true, Color: true, TrueColor: true},
+			expected: "\x1b[38;2;255;0;0m▀\x1b[0m\x1b[38;2;0;0;0;48;2;0;0;0m▀\x1b[0m\n",
+		},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			actual := c.term.Image(img)
+			if actual != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, actual)
+				return
+			}
+		})
+	}
+}
+
+func TestPadRight(t *testing.T) {
+	cases := []struct {
+		input    string
+		width    int
+		expected string
+	}{
+		{input: "hp", width: 4, expected: "hp  "},
+		{input: "Angriff", width: 4, expected: "Angriff"},
+		{input: "Pokémon", width: 8, expected: "Pokémon "},
+		{input: "ピカチュウ", width: 12, expected: "ピカチュウ  "},
+		{input: "\x1b[1mhp\x1b[0m", width: 3, expected: "\x1b[1mhp\x1b[0m "},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			actual := PadRight(c.input, c.width)
+			if actual != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, actual)
+				return
+			}
+		})
+	}
+}
+
+func TestVisibleWidth(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected int
+	}{
+		{"pikachu", 7},
+		{"Pokémon", 7},
+		{"ピカチュウ", 10},
+		{"でんき", 6},
+		{"피카츄", 6},
+		{"\x1b[33mピカチュウ\x1b[0m", 10},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			got := VisibleWidth(c.input)
+			if got != c.expected {
+				t.Errorf("expected %v for %q, got %v", c.expected, c.input, got)
+			}
+		})
+	}
+}
+
+func TestRenderWide(t *testing.T) {
+	tab := Table{
+		Header: []string{"Name", "Type"},
+		Rows: [][]string{
+			{"ピカチュウ", "でんき"},
+			{"pikachu", "electric"},
+		},
+	}
+	expected := "┌────────────┬──────────┐\n" +
+		"│ Name       │ Type     │\n" +
+		"├────────────┼──────────┤\n" +
+		"│ ピカチュウ │ でんき   │\n" +
+		"│ pikachu    │ electric │\n" +
+		"└────────────┴──────────┘\n"
+	got := Terminal{Boxed: true}.Render(tab)
+	if got != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, got)
+	}
+}
@@ -0,0 +1,36 @@
+package termui
+
+import "unicode"
+
+// wideRanges are the East Asian wide and fullwidth blocks, which take
+// two columns: Hangul Jamo, CJK punctuation, kana, ideographs, Hangul
+// syllables, fullwidth forms and emoji.
+var wideRanges = [][2]rune{
+	{0x1100, 0x115f},
+	{0x2e80, 0x303e},
+	{0x3041, 0x33ff},
+	{0x3400, 0x4dbf},
+	{0x4e00, 0x9fff},
+	{0xa000, 0xa4cf},
+	{0xac00, 0xd7a3},
+	{0xf900, 0xfaff},
+	{0xfe30, 0xfe4f},
+	{0xff00, 0xff60},
+	{0xffe0, 0xffe6},
+	{0x1f300, 0x1f64f},
+	{0x1f900, 0x1f9ff},
+	{0x20000, 0x3fffd},
+}
+
+// RuneWidth is the number of columns r takes up on screen.
+func RuneWidth(r rune) int {
+	if r == 0 || unicode.Is(unicode.Mn, r) || unicode.IsControl(r) {
+		return 0
+	}
+	for _, w := range wideRanges {
+		if r >= w[0] && r <= w[1] {
+			return 2
+		}
+	}
+	return 1
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/dudiko2/pokedexcli/internal/pokeapi"
+	"github.com/dudiko2/pokedexcli/internal/termui"
+)
+
+const languageEnvVar = "POKEDEX_LANG"
+
+func initialLanguage() string {
+	lang := strings.TrimSpace(os.Getenv(languageEnvVar))
+	if lang == "" {
+		return pokeapi.DefaultLanguage
+	}
+	return lang
+}
+
+func isDefaultLanguage(conf *config) bool {
+	return conf.language == pokeapi.DefaultLanguage
+}
+
+// formatLocalized shows a translated name next to the identifier that
+// commands accept, unless they're the same.
+func formatLocalized(local, id string) string {
+	if local == "" || strings.EqualFold(local, id) {
+		return id
+	}
+	return fmt.Sprintf("%s (%s)", local, id)
+}
+
+// textLabel picks a name from names in the current language. With the
+// default language, identifiers are shown as they always have been.
+func textLabel(names []pokeapi.LocationNames, id string, conf *config) label {
+	if isDefaultLanguage(conf) {
+		return label{Name: id}
+	}
+	local, _ := pokeapi.LocalizedName(names, conf.language)
+	if strings.EqualFold(local, id) {
+		local = ""
+	}
+	return label{Name: id, Local: local}
+}
+
+// refLabel looks up the name of the resource behind ref in the current
+// language. Lookup failures fall back to the identifier.
+func refLabel(ref pokeapi.NameURLPair, conf *config) label {
+	if isDefaultLanguage(conf) {
+		return label{Name: ref.Name}
+	}
+	ctx := context.Background()
+	res, err := pokeapi.Resolve[pokeapi.LocalizedResource](ctx, &conf.pokeapiClient, ref)
+	if err != nil {
+		return label{Name: ref.Name}
+	}
+	return textLabel(res.Names, ref.Name, conf)
+}
+
+func refLabels(refs []pokeapi.NameURLPair, conf *config) []label {
+	list := make([]label, 0, len(refs))
+	for _, r := range refs {
+		list = append(list, refLabel(r, conf))
+	}
+	return list
+}
+
+// pokemonLabel translates a Pokemon name through its species, since
+// Pokemon resources themselves carry no names.
+func pokemonLabel(name string, conf *config) label {
+	if isDefaultLanguage(conf) {
+		return label{Name: name}
+	}
+	p, err := conf.pokeapiClient.GetPokemonData(name)
+	if err != nil {
+		return label{Name: name}
+	}
+	l := refLabel(p.Species, conf)
+	l.Name = name
+	return l
+}
+
+func localGenus(s pokeapi.PokemonSpeciesRes, conf *config) string {
+	for _, l := range []string{conf.language, pokeapi.DefaultLanguage} {
+		for _, g := range s.Genera {
+			if g.Language.Name == l {
+				return g.Genus
+			}
+		}
+	}
+	return ""
+}
+
+type languageResult struct {
+	Language label `json:"language" yaml:"language"`
+	Changed  bool  `json:"changed" yaml:"changed"`
+}
+
+func (r languageResult) printText(ui termui.Terminal) {
+	if !r.Changed {
+		fmt.Printf("Language: %s", r.Language)
+		return
+	}
+	fmt.Printf("Language set to %s", r.Language)
+}
+
+func runLang(args commandArgs, conf *config) (result, error) {
+	code := args.get("language")
+	if code == "" {
+		return languageResult{Language: label{Name: conf.language}}, nil
+	}
+	lang, err := conf.pokeapiClient.GetLanguage(code)
+	if err != nil {
+		return nil, fmt.Errorf("unknown language %s: %w", code, err)
+	}
+	conf.language = lang.Name
+	r := languageResult{
+		Language: textLabel(lang.Names, lang.Name, conf),
+		Changed:  true,
+	}
+	return r, nil
+}
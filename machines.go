@@ -0,0 +1,145 @@
+package main
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dudiko2/pokedexcli/internal/pokeapi"
+	"github.com/dudiko2/pokedexcli/internal/termui"
+)
+
+var machineKindOrder = []string{"tm", "hm", "tr"}
+
+type machineNumber struct {
+	kind   string
+	number int
+	item   pokeapi.NameURLPair
+	move   pokeapi.NameURLPair
+}
+
+// parseMachineItem splits an item name such as "tm01" into its kind and
+// number.
+func parseMachineItem(item string) (string, int, error) {
+	for _, kind := range machineKindOrder {
+		digits, ok := strings.CutPrefix(item, kind)
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(digits)
+		if err != nil {
+			return "", 0, fmt.Errorf("invalid machine item %q", item)
+		}
+		return kind, n, nil
+	}
+	return "", 0, fmt.Errorf("invalid machine item %q", item)
+}
+
+func compareMachines(a, b machineNumber) int {
+	byKind := cmp.Compare(slices.Index(machineKindOrder, a.kind),
+		slices.Index(machineKindOrder, b.kind))
+	if byKind != 0 {
+		return byKind
+	}
+	return cmp.Compare(a.number, b.number)
+}
+
+func machineMoves(p pokeapi.PokemonRes, versionGroup string) []string {
+	moves := []string{}
+	for _, m := range p.Moves {
+		for _, d := range m.VersionGroupDetails {
+			if d.VersionGroup.Name == versionGroup && d.MoveLearnMethod.Name == "machine" {
+				moves = append(moves, m.Move.Name)
+				break
+			}
+		}
+	}
+	return moves
+}
+
+type machineResult struct {
+	Machine     label  `json:"machine" yaml:"machine"`
+	Move        label  `json:"move" yaml:"move"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// newMachineResult names the machine and its move in the current
+// language. The move was already fetched to find the machine, so only
+// the item is a new request, and only when there is something to
+// translate.
+func newMachineResult(m machineNumber, conf *config) machineResult {
+	name := fmt.Sprintf("%s%02d", strings.ToUpper(m.kind), m.number)
+	r := machineResult{
+		Machine: label{Name: name},
+		Move:    label{Name: m.move.Name},
+	}
+	if !isDefaultLanguage(conf) {
+		item, err := conf.pokeapiClient.GetItem(m.item.Name)
+		if err == nil {
+			r.Machine = textLabel(item.Names, name, conf)
+		}
+	}
+	move, err := conf.pokeapiClient.GetMove(m.move.Name)
+	if err != nil {
+		return r
+	}
+	r.Move = textLabel(move.Names, move.Name, conf)
+	r.Description, _ = pokeapi.LocalizedFlavorText(move.FlavorTextEntries, conf.language)
+	return r
+}
+
+type machineListResult struct {
+	Pokemon      label           `json:"pokemon" yaml:"pokemon"`
+	VersionGroup string          `json:"version_group" yaml:"version_group"`
+	Machines     []machineResult `json:"machines" yaml:"machines"`
+}
+
+func (r machineListResult) printText(ui termui.Terminal) {
+	if len(r.Machines) < 1 {
+		fmt.Printf("%s can't learn any machines in %s\n", r.Pokemon, r.VersionGroup)
+		return
+	}
+	fmt.Printf("Machines %s can use in %s:\n", r.Pokemon, r.VersionGroup)
+	tab := termui.Table{Header: []string{"Machine", "Move", "Description"}}
+	for _, m := range r.Machines {
+		tab.Rows = append(tab.Rows, []string{m.Machine.String(), m.Move.String(), m.Description})
+	}
+	fmt.Print(ui.Render(tab))
+}
+
+func runTMs(args commandArgs, conf *config) (result, error) {
+	pokemonID := args.get("pokemon")
+	versionGroup := normalizeID(args.get("version-group"))
+	pokemon, err := lookup("pokemon", pokemonID, conf, conf.pokeapiClient.GetPokemonData)
+	if err != nil {
+		return nil, err
+	}
+	machines := []machineNumber{}
+	for _, move := range machineMoves(pokemon, versionGroup) {
+		m, err := conf.pokeapiClient.FindMachine(move, versionGroup)
+		if errors.Is(err, pokeapi.ErrNoMachine) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		kind, n, err := parseMachineItem(m.Item.Name)
+		if err != nil {
+			return nil, err
+		}
+		machines = append(machines, machineNumber{kind: kind, number: n, item: m.Item, move: m.Move})
+	}
+	slices.SortFunc(machines, compareMachines)
+	r := machineListResult{
+		Pokemon:      pokemonLabel(pokemon.Name, conf),
+		VersionGroup: versionGroup,
+		Machines:     []machineResult{},
+	}
+	for _, m := range machines {
+		r.Machines = append(r.Machines, newMachineResult(m, conf))
+	}
+	return r, nil
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestParseMachineItem(t *testing.T) {
+	cases := []struct {
+		input  string
+		kind   string
+		number int
+		fails  bool
+	}{
+		{input: "tm01", kind: "tm", number: 1},
+		{input: "hm05", kind: "hm", number: 5},
+		{input: "tr10", kind: "tr", number: 10},
+		{input: "tm100", kind: "tm", number: 100},
+		{input: "tmxx", fails: true},
+		{input: "potion", fails: true},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			kind, number, err := parseMachineItem(c.input)
+			if c.fails {
+				if err == nil {
+					t.Errorf("expected an error for %s", c.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if kind != c.kind || number != c.number {
+				t.Errorf("expected %s %v, got %s %v", c.kind, c.number, kind, number)
+				return
+			}
+		})
+	}
+}
+
+func TestCompareMachines(t *testing.T) {
+	cases := []struct {
+		input    []machineNumber
+		expected []string
+	}{
+		{
+			input:    []machineNumber{{kind: "tm", number: 10}, {kind: "tm", number: 2}},
+			expected: []string{"tm2", "tm10"},
+		},
+		{
+			input:    []machineNumber{{kind: "tr", number: 1}, {kind: "hm", number: 3}, {kind: "tm", number: 50}},
+			expected: []string{"tm50", "hm3", "tr1"},
+		},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			slices.SortFunc(c.input, compareMachines)
+			actual := []string{}
+			for _, m := range c.input {
+				actual = append(actual, fmt.Sprintf("%s%v", m.kind, m.number))
+			}
+			if !slices.Equal(actual, c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, actual)
+				return
+			}
+		})
+	}
+}
@@ -1,22 +1,31 @@
 package main
 
 import (
-	"bufio"
+	"context"
 	"errors"
 	"fmt"
+	"image"
+	"io"
 	"math/rand"
-	"net/url"
 	"os"
-	"strconv"
+	"slices"
 	"time"
 
+	"github.com/dudiko2/pokedexcli/internal/lineedit"
 	"github.com/dudiko2/pokedexcli/internal/pokeapi"
+	"github.com/dudiko2/pokedexcli/internal/termui"
 )
 
 type cliCommand struct {
 	name        string
 	description string
-	callback    func(args []string, conf *config) error
+	category    string
+	aliases     []string
+	args        []cliArg
+	flags       []cliFlag
+	subcommands []cliCommand
+	examples    []string
+	callback    func(args commandArgs, conf *config) (result, error)
 }
 
 type commandMap map[string]cliCommand
@@ -25,116 +34,375 @@ func newCommands() commandMap {
 	m := commandMap{
 		"help": {
 			name:        "help",
-			description: "Displays usage info",
-			callback:    runHelp,
+			description: "Lists the commands, or describes one in detail",
+			category:    categorySystem,
+			args: []cliArg{
+				{name: "command", description: "command to describe", optional: true},
+				{name: "subcommand", description: "one of its subcommands", optional: true},
+			},
+			examples: []string{"help", "help catch", "help snapshot export"},
+			callback: runHelp,
 		},
 		"exit": {
 			name:        "exit",
 			description: "Exits the program",
+			category:    categorySystem,
+			aliases:     []string{"q"},
 			callback:    runExit,
 		},
 		"map": {
 			name:        "map",
 			description: "Displays the next 20 locations",
+			category:    categoryNavigation,
+			aliases:     []string{"m"},
 			callback:    runMapNext,
 		},
 		"mapb": {
 			name:        "mapb",
 			description: "Displays the previous 20 locations",
+			category:    categoryNavigation,
 			callback:    runMapBack,
 		},
+		"region": {
+			name:        "region",
+			description: "Travel to a region, or show the current one",
+			category:    categoryNavigation,
+			args:        []cliArg{{name: "name", description: "region to travel to", optional: true}},
+			examples:    []string{"region kanto"},
+			callback:    runRegion,
+		},
+		"locations": {
+			name:        "locations",
+			description: "Lists the locations in the current region",
+			category:    categoryNavigation,
+			callback:    runLocations,
+		},
+		"areas": {
+			name:        "areas",
+			description: "Lists the areas of a location and moves there",
+			category:    categoryNavigation,
+			args:        []cliArg{{name: "location", description: "defaults to the current location", optional: true}},
+			examples:    []string{"areas pallet-town"},
+			callback:    runAreas,
+		},
 		"explore": {
 			name:        "explore",
-			description: "Explore an area",
+			description: "Explore an area, or the current location",
+			category:    categoryNavigation,
+			args:        []cliArg{{name: "area", description: "location area to explore", optional: true}},
+			examples:    []string{"explore canalave-city-area", "explore"},
 			callback:    runExplore,
 		},
 		"catch": {
 			name:        "catch",
 			description: "Attempt to catch a Pokemon",
+			category:    categoryCollection,
+			aliases:     []string{"c"},
+			args:        []cliArg{{name: "id", description: "Pokemon name or number"}},
+			examples:    []string{"catch pikachu", "catch 25", `catch "Mr. Mime"`},
 			callback:    runCatch,
 		},
+		"natures": {
+			name:        "natures",
+			description: "Lists which stat each nature raises and lowers",
+			category:    categoryInfo,
+			callback:    runNatures,
+		},
+		"where": {
+			name:        "where",
+			description: "Lists where a Pokemon can be found in each game",
+			category:    categoryInfo,
+			args:        []cliArg{{name: "id", description: "Pokemon name or number"}},
+			examples:    []string{"where pikachu"},
+			callback:    runWhere,
+		},
 		"inspect": {
 			name:        "inspect",
 			description: "Inspect caught Pokemon",
+			category:    categoryCollection,
+			args:        []cliArg{{name: "id", description: "caught Pokemon name or number"}},
+			flags:       []cliFlag{{name: "sprite", description: "draw the Pokemon's sprite"}},
+			examples:    []string{"inspect pikachu", "inspect pikachu --sprite"},
 			callback:    runInspect,
 		},
+		"sprite": {
+			name:        "sprite",
+			description: "Draws a Pokemon's sprite",
+			category:    categoryInfo,
+			args:        []cliArg{{name: "pokemon", description: "Pokemon name or number"}},
+			flags: []cliFlag{
+				{name: "shiny", description: "draw the shiny coloring"},
+				{name: "back", description: "draw the Pokemon from behind"},
+				{name: "gen", value: "n", description: "use the sprite from a generation, 1-8"},
+			},
+			examples: []string{"sprite pikachu", "sprite charizard --shiny --back", "sprite mew --gen 1"},
+			callback: runSprite,
+		},
+		"tms": {
+			name:        "tms",
+			description: "Lists the TMs, HMs and TRs a Pokemon can use in a version group",
+			category:    categoryInfo,
+			args: []cliArg{
+				{name: "pokemon", description: "Pokemon name or number"},
+				{name: "version-group", description: "e.g. red-blue or sword-shield"},
+			},
+			examples: []string{"tms pikachu red-blue", "tms charizard sword-shield"},
+			callback: runTMs,
+		},
+		"breedable": {
+			name:        "breedable",
+			description: "Checks whether two caught Pokemon can breed",
+			category:    categoryCollection,
+			args: []cliArg{
+				{name: "first", description: "caught Pokemon"},
+				{name: "second", description: "caught Pokemon"},
+			},
+			examples: []string{"breedable ditto pikachu"},
+			callback: runBreedable,
+		},
+		"prefetch": {
+			name:        "prefetch",
+			description: "Caches many resources at once",
+			category:    categorySystem,
+			args:        []cliArg{{name: "endpoint", description: "e.g. pokemon or move"}},
+			flags: []cliFlag{
+				{name: "all", description: "fetch every resource in the endpoint"},
+				{name: "range", value: "from-to", description: "fetch resources by ID, e.g. 1-151"},
+			},
+			examples: []string{"prefetch pokemon --range 1-151", "prefetch nature --all"},
+			callback: runPrefetch,
+		},
+		"snapshot": {
+			name:        "snapshot",
+			description: "Exports or imports all cached data",
+			category:    categorySystem,
+			subcommands: []cliCommand{
+				{
+					name:        "export",
+					description: "Writes all cached data to a file",
+					args:        []cliArg{{name: "file", description: "snapshot to write"}},
+					callback:    runSnapshotExport,
+				},
+				{
+					name:        "import",
+					description: "Loads cached data from a file",
+					args:        []cliArg{{name: "file", description: "snapshot to read"}},
+					callback:    runSnapshotImport,
+				},
+			},
+			examples: []string{"snapshot export pokedex.tar.gz", "snapshot import pokedex.tar.gz"},
+		},
+		"lang": {
+			name:        "lang",
+			description: "Shows or sets the display language",
+			category:    categorySystem,
+			args:        []cliArg{{name: "language", description: "language code", optional: true}},
+			examples:    []string{"lang", "lang de"},
+			callback:    runLang,
+		},
+		"history": {
+			name:        "history",
+			description: "Lists previous commands, or the last n; rerun one with !n or !!",
+			category:    categorySystem,
+			args:        []cliArg{{name: "count", description: "how many to list", optional: true}},
+			examples:    []string{"history 10"},
+			callback:    runHistory,
+		},
+		"alias": {
+			name:        "alias",
+			description: "Lists aliases, or defines one that runs other commands; $1 to $9 and $@ stand for its arguments",
+			category:    categorySystem,
+			args: []cliArg{
+				{name: "name", description: "alias to show or define", optional: true},
+				{name: "expansion", description: "= followed by the commands to run, quoted if it contains ;", optional: true, variadic: true},
+			},
+			examples: []string{"alias", `alias cc = "catch $1; inspect $1"`, "alias kd = pokedex --region kanto"},
+			callback: runAlias,
+		},
+		"unalias": {
+			name:        "unalias",
+			description: "Removes an alias",
+			category:    categorySystem,
+			args:        []cliArg{{name: "name", description: "alias to remove"}},
+			callback:    runUnalias,
+		},
+		"format": {
+			name:        "format",
+			description: "Shows or sets how results are printed",
+			category:    categorySystem,
+			args:        []cliArg{{name: "format", description: "text, json or yaml", optional: true}},
+			examples:    []string{"format json", "format text"},
+			callback:    runFormat,
+		},
 		"pokedex": {
 			name:        "pokedex",
-			description: "List caughtr Pokemon",
-			callback:    runPokedex,
+			description: "List caught Pokemon, or progress in a region's Pokedex",
+			category:    categoryCollection,
+			flags: []cliFlag{
+				{name: "region", value: "name", description: "defaults to the current region", optionalValue: true},
+			},
+			examples: []string{"pokedex", "pokedex --region kanto", "pokedex --region"},
+			callback: runPokedex,
 		},
 	}
+	aliases := commandMap{}
+	for _, cmd := range m {
+		for _, alias := range cmd.aliases {
+			aliases[alias] = cmd
+		}
+	}
+	for alias, cmd := range aliases {
+		m[alias] = cmd
+	}
 	return m
 }
 
 type config struct {
-	pokeapiClient           pokeapi.Client
-	commands                commandMap
-	prevLocationAreasOffset int
-	nextLocationAreasOffset int
+	pokeapiClient        pokeapi.Client
+	commands             commandMap
+	prevLocationAreasURL *string
+	nextLocationAreasURL *string
+	currentRegion        string
+	currentLocation      string
+	language             string
+	nameIndex            map[string][]string
+	history              *lineedit.History
+	lastAreas            []string // shown by the last map or areas, for completion
+	lastPokemon          []string // found by the last explore, for completion
+	settings             settings
+	macroDepth           int
+	output               string
+	ui                   termui.Terminal
 }
 
 func newConfig() *config {
 	c := config{
-		pokeapiClient:           *pokeapi.NewClient(),
-		commands:                newCommands(),
-		prevLocationAreasOffset: -1,
-		nextLocationAreasOffset: -1,
+		pokeapiClient: *pokeapi.NewClient(cacheDir()),
+		commands:      newCommands(),
+		language:      initialLanguage(),
+		nameIndex:     map[string][]string{},
+		history:       newHistory(),
+		settings:      newSettings(),
+		output:        outputText,
+		ui:            termui.Detect(os.Stdout),
 	}
 	return &c
 }
 
-var caughtPokemon = map[string]pokeapi.PokemonRes{}
+// ownedPokemon is a caught Pokemon together with the traits it was
+// given when it was caught.
+type ownedPokemon struct {
+	pokeapi.PokemonRes
+	nature     pokeapi.NatureRes
+	growthRate pokeapi.GrowthRateRes
+	experience int
+}
+
+func newOwnedPokemon(pokemon pokeapi.PokemonRes, conf *config) (ownedPokemon, error) {
+	nature, err := randomNature(conf)
+	if err != nil {
+		return ownedPokemon{}, err
+	}
+	ctx := context.Background()
+	species, err := pokeapi.Resolve[pokeapi.PokemonSpeciesRes](ctx, &conf.pokeapiClient, pokemon.Species)
+	if err != nil {
+		return ownedPokemon{}, err
+	}
+	rate, err := pokeapi.Resolve[pokeapi.GrowthRateRes](ctx, &conf.pokeapiClient, species.GrowthRate)
+	if err != nil {
+		return ownedPokemon{}, err
+	}
+	level := randomWildLevel()
+	o := ownedPokemon{
+		PokemonRes: pokemon,
+		nature:     nature,
+		growthRate: rate,
+		experience: experienceForLevel(rate, level),
+	}
+	return o, nil
+}
+
+var caughtPokemon = map[string]ownedPokemon{}
+
+// seenPokemon holds every Pokemon encountered while exploring or
+// catching, whether or not it was caught.
+var seenPokemon = map[string]bool{}
 
 const locationAreasLimit = 20
 
+const prompt = "pokedex > "
+
 func main() {
-	conf := newConfig()
-	scanner := bufio.NewScanner(os.Stdin)
+	os.Exit(run(os.Args[1:]))
+}
+
+// runREPL reads and runs commands interactively until end of input.
+func runREPL(conf *config) int {
+	editor := lineedit.New(os.Stdin, os.Stdout)
+	editor.Complete = func(before string) []string {
+		return completeInput(before, conf)
+	}
+	editor.History = conf.history
 	for {
-		printPrompt()
-		input := prepareInput(scanner)
-		execCommand(input, conf)
+		input, err := prepareInput(editor, conf.history)
+		if errors.Is(err, lineedit.ErrInterrupted) {
+			continue
+		}
+		if errors.Is(err, io.EOF) {
+			return exitOK
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return exitFailure
+		}
+		if input.command == "" {
+			continue
+		}
+		err = execCommand(input, conf)
+		if errors.Is(err, errExit) {
+			return exitOK
+		}
+		if errors.Is(err, errUnknownCommand) {
+			printUnknownCmd(input.command, conf)
+		} else if err != nil {
+			fmt.Printf("%s %v", failure(conf.ui, "Error:"), err)
+		}
+		fmt.Println("")
 	}
 }
 
-func printPrompt() {
-	fmt.Print("pokedex > ")
+// printUnknownCmd suggests the commands cmd may have meant, or shows
+// the full help when nothing is close.
+func printUnknownCmd(cmd string, conf *config) {
+	hint := didYouMean(cmd, commandNames(conf))
+	fmt.Printf("Unknown command: %s%s\n", cmd, hint)
+	if hint == "" {
+		printHelp(conf)
+	}
 }
 
-func printUnknownCmd(cmd string) {
-	fmt.Println("Unknown command: " + cmd)
-}
+var errUnknownCommand = errors.New("unknown command")
+
+// errExit is returned by the exit command. Whoever runs commands stops
+// there and exits with the code it has so far.
+var errExit = errors.New("exit")
 
-func execCommand(inp parsedInput, conf *config) {
+func execCommand(inp parsedInput, conf *config) error {
 	if inp.command == "" {
-		return
+		return nil
 	}
-	defer fmt.Println("")
 	cmd, ok := conf.commands[inp.command]
-	if !ok {
-		printUnknownCmd(inp.command)
-		runHelp(inp.arguments, conf)
-		return
+	if expansion, isAlias := conf.settings.Aliases[inp.command]; !ok && isAlias {
+		return runMacro(inp, expansion, conf)
 	}
-	err := cmd.callback(inp.arguments, conf)
-	if err != nil {
-		fmt.Printf("Error: %v", err)
-	}
-}
-
-func runHelp(args []string, conf *config) error {
-	res := "Usage:\n\n"
-	for name, cmd := range conf.commands {
-		res += fmt.Sprintf("%s: %s\n", name, cmd.description)
+	if !ok {
+		return unknownCommandError(inp.command, conf)
 	}
-	fmt.Print(res)
-	return nil
+	return runCommand(cmd, inp.arguments, conf)
 }
 
-func runExit(args []string, c *config) error {
-	os.Exit(0)
-	return nil
+func runExit(args commandArgs, c *config) (result, error) {
+	return nil, errExit
 }
 
 func forEach[T any](list []T, callback func(T, int)) {
@@ -143,80 +411,105 @@ func forEach[T any](list []T, callback func(T, int)) {
 	}
 }
 
-func printLocationAreasNames(la []pokeapi.LocationAreasEntry) {
-	forEach(la, func(area pokeapi.LocationAreasEntry, i int) {
-		fmt.Println(area.Name)
+// areaListResult is a page of location areas.
+type areaListResult struct {
+	Areas []label `json:"areas" yaml:"areas"`
+}
+
+func (r areaListResult) printText(ui termui.Terminal) {
+	forEach(r.Areas, func(area label, i int) {
+		fmt.Println(area)
 	})
 }
 
-func maxInt(a, b int) int {
-	if a > b {
-		return a
+func getLocations(pageURL *string, conf *config) (result, error) {
+	if pageURL == nil {
+		first := pokeapi.ListURL("location-area", 0, locationAreasLimit)
+		pageURL = &first
 	}
-	return b
+	ctx := context.Background()
+	d, err := conf.pokeapiClient.GetLocationAreas(ctx, *pageURL)
+	if err != nil {
+		return nil, err
+	}
+	conf.lastAreas = names(d.Results)
+	conf.nextLocationAreasURL = d.Next
+	conf.prevLocationAreasURL = d.Previous
+	return areaListResult{Areas: refLabels(d.Results, conf)}, nil
 }
 
-func parseOffsetParam(urlString *string) int {
-	if urlString == nil {
-		return -1
-	}
-	// XXX handle errs
-	u, _ := url.Parse(*urlString)
-	offsetParam, _ := strconv.Atoi(u.Query().Get("offset"))
-	return offsetParam
+func runMapNext(args commandArgs, conf *config) (result, error) {
+	return getLocations(conf.nextLocationAreasURL, conf)
 }
 
-func getLocations(offset int, conf *config) error {
-	pl := pokeapi.GetLocationAreasPayload{
-		Offset: offset,
-		Limit:  locationAreasLimit,
+func runMapBack(args commandArgs, conf *config) (result, error) {
+	return getLocations(conf.prevLocationAreasURL, conf)
+}
+
+// areaExploreResult lists the Pokemon found in one location area.
+type areaExploreResult struct {
+	Area    label   `json:"area" yaml:"area"`
+	Pokemon []label `json:"pokemon" yaml:"pokemon"`
+}
+
+func (r areaExploreResult) printText(ui termui.Terminal) {
+	fmt.Printf("Exploring %s...\n", r.Area)
+	if len(r.Pokemon) < 1 {
+		fmt.Println("No Pokemon found!")
+		return
 	}
-	d, err := conf.pokeapiClient.GetLocationAreas(pl)
-	if err != nil {
-		return err
+	fmt.Printf("Found Pokemon in %s:\n", r.Area)
+	for _, p := range r.Pokemon {
+		fmt.Printf("- %s\n", p)
 	}
-	locationAreas := d.Results
-	printLocationAreasNames(locationAreas)
-	conf.nextLocationAreasOffset = parseOffsetParam(d.Next)
-	conf.prevLocationAreasOffset = parseOffsetParam(d.Previous)
-	return nil
 }
 
-func runMapNext(args []string, conf *config) error {
-	offset := maxInt(0, conf.nextLocationAreasOffset)
-	return getLocations(offset, conf)
+// newAreaExploreResult also marks the Pokemon found as seen.
+func newAreaExploreResult(l pokeapi.LocationRes, conf *config) areaExploreResult {
+	r := areaExploreResult{
+		Area:    textLabel(l.Names, l.Name, conf),
+		Pokemon: []label{},
+	}
+	for _, e := range l.PokemonEncounters {
+		seenPokemon[e.Pokemon.Name] = true
+		conf.lastPokemon = append(conf.lastPokemon, e.Pokemon.Name)
+		r.Pokemon = append(r.Pokemon, pokemonLabel(e.Pokemon.Name, conf))
+	}
+	return r
 }
 
-func runMapBack(args []string, conf *config) error {
-	offset := maxInt(0, conf.prevLocationAreasOffset)
-	return getLocations(offset, conf)
+// exploreResult holds every area explored in a location.
+type exploreResult struct {
+	Location label               `json:"location" yaml:"location"`
+	Areas    []areaExploreResult `json:"areas" yaml:"areas"`
 }
 
-func printLocationExplore(l pokeapi.LocationRes) {
-	encountersLen := len(l.PokemonEncounters)
-	if encountersLen < 1 {
-		fmt.Println("No Pokemon found!")
+func (r exploreResult) printText(ui termui.Terminal) {
+	if len(r.Areas) < 1 {
+		fmt.Printf("There is nothing to explore in %s\n", r.Location)
 		return
 	}
-	fmt.Printf("Found Pokemon in %s:\n", l.Name)
-	for _, e := range l.PokemonEncounters {
-		fmt.Printf("- %s\n", e.Pokemon.Name)
+	for _, a := range r.Areas {
+		a.printText(ui)
 	}
 }
 
-func runExplore(args []string, conf *config) error {
-	argsLen := len(args)
-	if argsLen < 1 {
-		return errors.New("missing argument: id")
+func runExplore(args commandArgs, conf *config) (result, error) {
+	conf.lastPokemon = nil
+	locationID := args.get("area")
+	if locationID == "" {
+		return exploreCurrentLocation(conf)
 	}
-	locationID := args[0]
-	fmt.Printf("Exploring %s...\n", locationID)
-	d, err := conf.pokeapiClient.GetLocationArea(locationID)
+	d, err := lookup("location-area", locationID, conf, conf.pokeapiClient.GetLocationArea)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	conf.currentLocation = d.Location.Name
+	r := exploreResult{
+		Location: refLabel(d.Location, conf),
+		Areas:    []areaExploreResult{newAreaExploreResult(d, conf)},
 	}
-	printLocationExplore(d)
-	return nil
+	return r, nil
 }
 
 func calculateChance(value int) bool {
@@ -226,56 +519,206 @@ func calculateChance(value int) bool {
 	return num < 20
 }
 
-func runCatch(args []string, conf *config) error {
-	argsLen := len(args)
-	if argsLen < 1 {
-		return errors.New("missing argument: id")
+type catchResult struct {
+	Pokemon  label     `json:"pokemon" yaml:"pokemon"`
+	Caught   bool      `json:"caught" yaml:"caught"`
+	Level    int       `json:"level,omitempty" yaml:"level,omitempty"`
+	LevelUps []levelUp `json:"level_ups,omitempty" yaml:"level_ups,omitempty"`
+}
+
+func (r catchResult) printText(ui termui.Terminal) {
+	fmt.Printf("Throwing a Pokeball at %s...\n", r.Pokemon)
+	if !r.Caught {
+		fmt.Print(failure(ui, fmt.Sprintf("%s escaped!", r.Pokemon)))
+		return
 	}
-	pokemonID := args[0]
-	pokemon, err := conf.pokeapiClient.GetPokemonData(pokemonID)
+	fmt.Print(success(ui, fmt.Sprintf("%s was caught at level %v!", r.Pokemon, r.Level)))
+	for _, u := range r.LevelUps {
+		fmt.Printf("\n%s grew to level %s!", u.Pokemon, heading(ui, fmt.Sprint(u.Level)))
+	}
+}
+
+func runCatch(args commandArgs, conf *config) (result, error) {
+	pokemonID := args.get("id")
+	pokemon, err := lookup("pokemon", pokemonID, conf, conf.pokeapiClient.GetPokemonData)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	seenPokemon[pokemon.Name] = true
+	r := catchResult{
+		Pokemon: pokemonLabel(pokemon.Name, conf),
+		Caught:  calculateChance(pokemon.BaseExperience),
 	}
-	fmt.Printf("Throwing a Pokeball at %s...\n", pokemon.Name)
-	caught := calculateChance(pokemon.BaseExperience)
-	if caught {
-		fmt.Printf("%s was caught!", pokemon.Name)
-		caughtPokemon[pokemon.Name] = pokemon
-	} else {
-		fmt.Printf("%s escaped!", pokemon.Name)
+	if !r.Caught {
+		return r, nil
 	}
-	return nil
+	// the nature and growth rate are only fetched for a caught Pokemon
+	owned, err := newOwnedPokemon(pokemon, conf)
+	if err != nil {
+		return nil, err
+	}
+	r.Level = owned.level()
+	r.LevelUps = awardExperience(experienceYield(pokemon, r.Level), conf)
+	caughtPokemon[pokemon.Name] = owned
+	return r, nil
 }
 
-func printPokemon(p pokeapi.PokemonRes) {
-	fmt.Printf("Name: %s\n", p.Name)
-	fmt.Printf("Height: %v\n", p.Height)
+type statResult struct {
+	Stat     label `json:"stat" yaml:"stat"`
+	BaseStat int   `json:"base_stat" yaml:"base_stat"`
+	Value    int   `json:"value" yaml:"value"`
+	// Nature is "+" or "-" for the stats the nature raises or lowers.
+	Nature string `json:"nature,omitempty" yaml:"nature,omitempty"`
 }
 
-func runInspect(args []string, conf *config) error {
-	argsLen := len(args)
-	if argsLen < 1 {
-		return errors.New("missing argument: id")
+type pokemonResult struct {
+	Name                label        `json:"name" yaml:"name"`
+	Species             string       `json:"species,omitempty" yaml:"species,omitempty"`
+	Description         string       `json:"description,omitempty" yaml:"description,omitempty"`
+	Types               []label      `json:"types" yaml:"types"`
+	Height              int          `json:"height" yaml:"height"`
+	Weight              int          `json:"weight" yaml:"weight"`
+	Nature              natureResult `json:"nature" yaml:"nature"`
+	Level               int          `json:"level" yaml:"level"`
+	Experience          int          `json:"experience" yaml:"experience"`
+	LevelExperience     int          `json:"level_experience" yaml:"level_experience"`
+	NextLevelExperience int          `json:"next_level_experience,omitempty" yaml:"next_level_experience,omitempty"`
+	Stats               []statResult `json:"stats" yaml:"stats"`
+	Sprite              string       `json:"sprite,omitempty" yaml:"sprite,omitempty"`
+	sprite              image.Image
+}
+
+// levelProgress shows how far the Pokemon is towards its next level.
+func (r pokemonResult) levelProgress(ui termui.Terminal) string {
+	if r.NextLevelExperience == 0 {
+		return "(max level)"
 	}
-	pokemonName := args[0]
-	p, caught := caughtPokemon[pokemonName]
-	if !caught {
-		fmt.Printf("you have not caught %s\n", pokemonName)
-		return nil
+	done := r.Experience - r.LevelExperience
+	needed := r.NextLevelExperience - r.LevelExperience
+	bar := ui.Bar(done, needed, progressBarWidth, termui.Cyan)
+	return fmt.Sprintf("[%s] %v/%v exp to level %v", bar, done, needed, r.Level+1)
+}
+
+func (r pokemonResult) printText(ui termui.Terminal) {
+	if r.sprite != nil {
+		fmt.Print(ui.Image(r.sprite))
+	}
+	fmt.Printf("Name: %s\n", r.Name)
+	if r.Species != "" {
+		fmt.Printf("Species: %s\n", r.Species)
+	}
+	if r.Description != "" {
+		fmt.Printf("Description: %s\n", r.Description)
 	}
-	printPokemon(p)
-	return nil
+	if len(r.Types) > 0 {
+		fmt.Printf("Types: %s\n", paintTypes(ui, r.Types))
+	}
+	fmt.Printf("Height: %v\n", r.Height)
+	fmt.Printf("Weight: %v\n", r.Weight)
+	fmt.Printf("Nature: %s\n", r.Nature)
+	fmt.Printf("Level: %v %s\n", r.Level, r.levelProgress(ui))
+	tab := termui.Table{
+		Header: []string{"Stat", "Value", "Base"},
+		Right:  []int{1},
+	}
+	for _, s := range r.Stats {
+		value := fmt.Sprint(s.Value)
+		if s.Nature != "" {
+			value += " (" + s.Nature + ")"
+		}
+		bar := ui.Bar(s.BaseStat, maxBaseStat, statBarWidth, statColor(s.BaseStat))
+		tab.Rows = append(tab.Rows, []string{s.Stat.String(), value, fmt.Sprintf("%s %3d", bar, s.BaseStat)})
+	}
+	fmt.Print(ui.Render(tab))
+}
+
+func newPokemonResult(p ownedPokemon, conf *config) pokemonResult {
+	level := p.level()
+	r := pokemonResult{
+		Name:       pokemonLabel(p.Name, conf),
+		Height:     p.Height,
+		Weight:     p.Weight,
+		Nature:     newNatureResult(p.nature, conf),
+		Level:      level,
+		Experience: p.experience,
+		Stats:      []statResult{},
+		Types:      []label{},
+	}
+	r.LevelExperience = experienceForLevel(p.growthRate, level)
+	if level < maxLevel(p.growthRate) {
+		r.NextLevelExperience = experienceForLevel(p.growthRate, level+1)
+	}
+	species, err := conf.pokeapiClient.GetPokemonSpecies(p.Species.Name)
+	if err == nil {
+		r.Species = localGenus(species, conf)
+		r.Description, _ = pokeapi.LocalizedFlavorText(species.FlavorTextEntries, conf.language)
+	}
+	for _, t := range p.Types {
+		r.Types = append(r.Types, refLabel(t.Type, conf))
+	}
+	for _, s := range p.Stats {
+		r.Stats = append(r.Stats, statResult{
+			Stat:     refLabel(s.Stat, conf),
+			BaseStat: s.BaseStat,
+			Value:    calculateStat(s.Stat.Name, s.BaseStat, level, p.nature),
+			Nature:   natureEffect(p.nature, s.Stat.Name),
+		})
+	}
+	return r
+}
+
+func runInspect(args commandArgs, conf *config) (result, error) {
+	p, err := findCaught(args.get("id"))
+	if err != nil {
+		return nil, err
+	}
+	r := newPokemonResult(p, conf)
+	if !args.has("sprite") {
+		return r, nil
+	}
+	r.Sprite, err = spriteURL(p.PokemonRes, 0, false, false)
+	if err != nil {
+		return nil, err
+	}
+	if conf.output == outputText {
+		r.sprite, err = loadSprite(r.Sprite, conf)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+type caughtListResult struct {
+	Pokemon []label `json:"pokemon" yaml:"pokemon"`
 }
 
-func runPokedex(args []string, conf *config) error {
-	pokedexSize := len(caughtPokemon)
-	if pokedexSize < 1 {
+func (r caughtListResult) printText(ui termui.Terminal) {
+	if len(r.Pokemon) < 1 {
 		fmt.Println("Your Pokedex is empty")
-		return nil
+		return
 	}
 	fmt.Println("Your Pokedex:")
-	for pokemonName := range caughtPokemon {
-		fmt.Printf("- %s\n", pokemonName)
+	for _, p := range r.Pokemon {
+		fmt.Printf("- %s\n", p)
+	}
+}
+
+func runPokedex(args commandArgs, conf *config) (result, error) {
+	if region, ok := args.flag("region"); ok {
+		if region == "" {
+			region = conf.currentRegion
+		}
+		if region == "" {
+			return nil, errors.New("missing argument: region")
+		}
+		return newRegionalPokedexResult(region, conf)
+	}
+	caught := caughtNames()
+	slices.Sort(caught)
+	r := caughtListResult{Pokemon: []label{}}
+	for _, name := range caught {
+		r.Pokemon = append(r.Pokemon, pokemonLabel(name, conf))
 	}
-	return nil
+	return r, nil
 }
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dudiko2/pokedexcli/internal/termui"
+)
+
+func printNames(list []label) {
+	forEach(list, func(item label, i int) {
+		fmt.Printf("- %s\n", item)
+	})
+}
+
+type regionResult struct {
+	Region    label `json:"region" yaml:"region"`
+	Locations int   `json:"locations,omitempty" yaml:"locations,omitempty"`
+	Traveled  bool  `json:"traveled" yaml:"traveled"`
+}
+
+func (r regionResult) printText(ui termui.Terminal) {
+	if !r.Traveled {
+		fmt.Printf("You are in %s\n", r.Region)
+		return
+	}
+	fmt.Printf("Traveled to %s (%v locations)\n", r.Region, r.Locations)
+}
+
+func runRegion(args commandArgs, conf *config) (result, error) {
+	regionID := args.get("name")
+	if regionID == "" {
+		if conf.currentRegion == "" {
+			return nil, errors.New("missing argument: name")
+		}
+		return regionResult{Region: label{Name: conf.currentRegion}}, nil
+	}
+	region, err := lookup("region", regionID, conf, conf.pokeapiClient.GetRegion)
+	if err != nil {
+		return nil, err
+	}
+	if region.Name != conf.currentRegion {
+		conf.currentLocation = ""
+	}
+	conf.currentRegion = region.Name
+	r := regionResult{
+		Region:    textLabel(region.Names, region.Name, conf),
+		Locations: len(region.Locations),
+		Traveled:  true,
+	}
+	return r, nil
+}
+
+type locationListResult struct {
+	Region    label   `json:"region" yaml:"region"`
+	Locations []label `json:"locations" yaml:"locations"`
+}
+
+func (r locationListResult) printText(ui termui.Terminal) {
+	if len(r.Locations) < 1 {
+		fmt.Printf("No locations found in %s\n", r.Region)
+		return
+	}
+	fmt.Printf("Locations in %s:\n", r.Region)
+	printNames(r.Locations)
+}
+
+func runLocations(args commandArgs, conf *config) (result, error) {
+	if conf.currentRegion == "" {
+		return nil, errors.New("no region selected, use: region <name>")
+	}
+	region, err := conf.pokeapiClient.GetRegion(conf.currentRegion)
+	if err != nil {
+		return nil, err
+	}
+	r := locationListResult{
+		Region:    textLabel(region.Names, region.Name, conf),
+		Locations: refLabels(region.Locations, conf),
+	}
+	return r, nil
+}
+
+type locationAreasResult struct {
+	Location label   `json:"location" yaml:"location"`
+	Areas    []label `json:"areas" yaml:"areas"`
+}
+
+func (r locationAreasResult) printText(ui termui.Terminal) {
+	if len(r.Areas) < 1 {
+		fmt.Printf("No areas found in %s\n", r.Location)
+		return
+	}
+	fmt.Printf("Areas in %s:\n", r.Location)
+	printNames(r.Areas)
+}
+
+func runAreas(args commandArgs, conf *config) (result, error) {
+	locationID := args.get("location")
+	if locationID == "" {
+		locationID = conf.currentLocation
+	}
+	if locationID == "" {
+		return nil, errors.New("missing argument: location")
+	}
+	location, err := lookup("location", locationID, conf, conf.pokeapiClient.GetLocation)
+	if err != nil {
+		return nil, err
+	}
+	conf.currentLocation = location.Name
+	conf.currentRegion = location.Region.Name
+	if len(location.Areas) > 0 {
+		conf.lastAreas = names(location.Areas)
+	}
+	r := locationAreasResult{
+		Location: textLabel(location.Names, location.Name, conf),
+		Areas:    refLabels(location.Areas, conf),
+	}
+	return r, nil
+}
+
+func exploreCurrentLocation(conf *config) (result, error) {
+	if conf.currentLocation == "" {
+		return nil, errors.New("missing argument: id (or move to a location with: areas <location>)")
+	}
+	location, err := conf.pokeapiClient.GetLocation(conf.currentLocation)
+	if err != nil {
+		return nil, err
+	}
+	r := exploreResult{
+		Location: textLabel(location.Names, location.Name, conf),
+		Areas:    []areaExploreResult{},
+	}
+	for _, area := range location.Areas {
+		d, err := conf.pokeapiClient.GetLocationArea(area.Name)
+		if err != nil {
+			return nil, err
+		}
+		r.Areas = append(r.Areas, newAreaExploreResult(d, conf))
+	}
+	return r, nil
+}
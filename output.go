@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/dudiko2/pokedexcli/internal/termui"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+	outputYAML = "yaml"
+)
+
+var outputFormats = []string{outputText, outputJSON, outputYAML}
+
+// result is what a command produces. The JSON and YAML formats encode
+// its exported fields; printText is the plain text rendering.
+type result interface {
+	printText(ui termui.Terminal)
+}
+
+func parseOutputFormat(s string) (string, error) {
+	format := strings.ToLower(strings.TrimSpace(s))
+	if !slices.Contains(outputFormats, format) {
+		return "", fmt.Errorf("unknown output format %s, expected %s", s, strings.Join(outputFormats, ", "))
+	}
+	return format, nil
+}
+
+// render prints res in the current output format. Commands with nothing
+// to show return a nil result.
+func render(res result, conf *config) error {
+	if res == nil {
+		return nil
+	}
+	switch conf.output {
+	case outputJSON:
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		return enc.Encode(res)
+	case outputYAML:
+		fmt.Println("---")
+		enc := yaml.NewEncoder(os.Stdout)
+		enc.SetIndent(2)
+		err := enc.Encode(res)
+		if err != nil {
+			return err
+		}
+		return enc.Close()
+	}
+	res.printText(conf.ui)
+	return nil
+}
+
+// printStatus shows progress while a command runs. It goes to stderr
+// unless the output is text, so it doesn't mix with encoded results.
+func printStatus(conf *config, format string, a ...any) {
+	if conf.output == outputText {
+		fmt.Printf(format, a...)
+		return
+	}
+	fmt.Fprintf(os.Stderr, format, a...)
+}
+
+// messageResult is a one line confirmation.
+type messageResult struct {
+	Message string `json:"message" yaml:"message"`
+}
+
+func (r messageResult) printText(ui termui.Terminal) {
+	fmt.Print(r.Message)
+}
+
+// label is an identifier that commands accept, with its name in the
+// display language when that differs.
+type label struct {
+	Name  string `json:"name" yaml:"name"`
+	Local string `json:"local_name,omitempty" yaml:"local_name,omitempty"`
+}
+
+func (l label) String() string {
+	return formatLocalized(l.Local, l.Name)
+}
+
+type formatResult struct {
+	Format string `json:"format" yaml:"format"`
+}
+
+func (r formatResult) printText(ui termui.Terminal) {
+	fmt.Printf("Output format: %s", r.Format)
+}
+
+func runFormat(args commandArgs, conf *config) (result, error) {
+	if name := args.get("format"); name != "" {
+		format, err := parseOutputFormat(name)
+		if err != nil {
+			return nil, err
+		}
+		conf.output = format
+	}
+	return formatResult{Format: conf.output}, nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParseOutputFormat(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+		fails    bool
+	}{
+		{"text", outputText, false},
+		{"JSON", outputJSON, false},
+		{" yaml ", outputYAML, false},
+		{"xml", "", true},
+		{"", "", true},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			got, err := parseOutputFormat(c.input)
+			if (err != nil) != c.fails {
+				t.Errorf("expected failure=%v for %q, got %v", c.fails, c.input, err)
+				return
+			}
+			if got != c.expected {
+				t.Errorf("expected %q for %q, got %q", c.expected, c.input, got)
+			}
+		})
+	}
+}
+
+func TestNatureResultString(t *testing.T) {
+	attack := label{Name: "attack"}
+	speed := label{Name: "speed", Local: "Initiative"}
+	cases := []struct {
+		input    natureResult
+		expected string
+	}{
+		{natureResult{}, "unknown"},
+		{natureResult{Name: label{Name: "hardy"}}, "hardy (neutral)"},
+		{natureResult{Name: label{Name: "adamant"}, Raises: &attack, Lowers: &speed}, "adamant (+attack, -Initiative (speed))"},
+		{natureResult{Name: label{Name: "bold", Local: "Kühn"}}, "Kühn (bold) (neutral)"},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			got := c.input.String()
+			if got != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/dudiko2/pokedexcli/internal/pokeapi"
+	"github.com/dudiko2/pokedexcli/internal/termui"
+)
+
+// getPokedexForRegion accepts either a pokedex name ("kanto",
+// "original-johto") or a region name ("johto"), in which case the
+// region's first pokedex is used.
+func getPokedexForRegion(name string, conf *config) (pokeapi.PokedexRes, error) {
+	name = normalizeID(name)
+	dex, err := conf.pokeapiClient.GetPokedex(name)
+	if err == nil {
+		return dex, nil
+	}
+	region, regionErr := conf.pokeapiClient.GetRegion(name)
+	if regionErr != nil || len(region.Pokedexes) < 1 {
+		return pokeapi.PokedexRes{}, err
+	}
+	return conf.pokeapiClient.GetPokedex(region.Pokedexes[0].Name)
+}
+
+func caughtSpecies() map[string]bool {
+	species := map[string]bool{}
+	for name, p := range caughtPokemon {
+		species[name] = true
+		species[p.Species.Name] = true
+	}
+	return species
+}
+
+type pokedexEntryResult struct {
+	Number  int    `json:"number" yaml:"number"`
+	Species label  `json:"species" yaml:"species"`
+	Status  string `json:"status" yaml:"status"`
+}
+
+type regionalPokedexResult struct {
+	Pokedex label                `json:"pokedex" yaml:"pokedex"`
+	Entries []pokedexEntryResult `json:"entries" yaml:"entries"`
+	Caught  int                  `json:"caught" yaml:"caught"`
+	Seen    int                  `json:"seen" yaml:"seen"`
+}
+
+func (r regionalPokedexResult) printText(ui termui.Terminal) {
+	total := len(r.Entries)
+	if total < 1 {
+		fmt.Printf("The %s Pokedex has no entries\n", r.Pokedex)
+		return
+	}
+	fmt.Printf("%s Pokedex:\n", r.Pokedex)
+	tab := termui.Table{Header: []string{"#", "Pokemon", "Status"}}
+	for _, e := range r.Entries {
+		status := ui.Paint(e.Status, pokedexStatusColors[e.Status])
+		tab.Rows = append(tab.Rows, []string{fmt.Sprintf("%03d", e.Number), e.Species.String(), status})
+	}
+	fmt.Print(ui.Render(tab))
+	percent := float64(r.Caught) / float64(total) * 100
+	fmt.Printf("Caught %v/%v (%.1f%%), seen %v\n", r.Caught, total, percent, r.Seen)
+}
+
+func newRegionalPokedexResult(name string, conf *config) (result, error) {
+	dex, err := getPokedexForRegion(name, conf)
+	if err != nil {
+		return nil, err
+	}
+	entries := slices.Clone(dex.PokemonEntries)
+	slices.SortFunc(entries, func(a, b pokeapi.PokedexEntry) int {
+		return cmp.Compare(a.EntryNumber, b.EntryNumber)
+	})
+	r := regionalPokedexResult{
+		Pokedex: textLabel(dex.Names, dex.Name, conf),
+		Entries: []pokedexEntryResult{},
+	}
+	caught := caughtSpecies()
+	for _, e := range entries {
+		species := e.PokemonSpecies.Name
+		status := "missing"
+		if caught[species] {
+			status = "caught"
+			r.Caught++
+			r.Seen++
+		} else if seenPokemon[species] {
+			status = "seen"
+			r.Seen++
+		}
+		r.Entries = append(r.Entries, pokedexEntryResult{
+			Number:  e.EntryNumber,
+			Species: refLabel(e.PokemonSpecies, conf),
+			Status:  status,
+		})
+	}
+	return r, nil
+}
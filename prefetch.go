@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/dudiko2/pokedexcli/internal/pokeapi"
+	"github.com/dudiko2/pokedexcli/internal/termui"
+)
+
+const (
+	prefetchWorkers       = 8
+	prefetchErrorsToPrint = 5
+)
+
+type prefetchError struct {
+	path string
+	err  error
+}
+
+// parseRange parses an inclusive "from-to" range of resource IDs.
+func parseRange(s string) (int, int, error) {
+	fromStr, toStr, ok := strings.Cut(s, "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid range %q, expected from-to", s)
+	}
+	from, err := strconv.Atoi(fromStr)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid range %q, expected from-to", s)
+	}
+	to, err := strconv.Atoi(toStr)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid range %q, expected from-to", s)
+	}
+	if from < 1 || to < from {
+		return 0, 0, fmt.Errorf("invalid range %q", s)
+	}
+	return from, to, nil
+}
+
+// prefetchPaths lists the resources to fetch for the given flags. --all
+// walks the endpoint's list, --range enumerates IDs directly. Either
+// way the client caches each resource under its ID and its name.
+func prefetchPaths(ctx context.Context, endpoint string, args commandArgs, conf *config) ([]string, error) {
+	idRange, hasRange := args.flag("range")
+	switch {
+	case args.has("all") && hasRange:
+		return nil, errors.New("use either --all or --range, not both")
+	case args.has("all"):
+		paths := []string{}
+		p := pokeapi.List[pokeapi.NameURLPair](ctx, &conf.pokeapiClient, endpoint)
+		for p.Next() {
+			paths = append(paths, endpoint+"/"+p.Item().Name)
+		}
+		return paths, p.Err()
+	case hasRange:
+		from, to, err := parseRange(idRange)
+		if err != nil {
+			return nil, err
+		}
+		paths := make([]string, 0, to-from+1)
+		for id := from; id <= to; id++ {
+			paths = append(paths, fmt.Sprintf("%s/%v", endpoint, id))
+		}
+		return paths, nil
+	}
+	return nil, errors.New("missing flag: --all or --range <from-to>")
+}
+
+// warmAll fetches every path through a bounded pool of workers, calling
+// progress after each one. The client's rate limiter paces the actual
+// network requests.
+func warmAll(ctx context.Context, paths []string, conf *config, progress func(done int)) []prefetchError {
+	jobs := make(chan string)
+	results := make(chan prefetchError)
+	var wg sync.WaitGroup
+	for range prefetchWorkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for path := range jobs {
+				err := conf.pokeapiClient.Warm(ctx, path)
+				results <- prefetchError{path: path, err: err}
+			}
+		}()
+	}
+	go func() {
+		defer close(jobs)
+		for _, path := range paths {
+			select {
+			case jobs <- path:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	failed := []prefetchError{}
+	done := 0
+	for r := range results {
+		done++
+		if r.err != nil && !errors.Is(r.err, context.Canceled) {
+			failed = append(failed, r)
+		}
+		progress(done)
+	}
+	return failed
+}
+
+type prefetchFailure struct {
+	Path  string `json:"path" yaml:"path"`
+	Error string `json:"error" yaml:"error"`
+}
+
+type prefetchResult struct {
+	Endpoint  string `json:"endpoint" yaml:"endpoint"`
+	Total     int    `json:"total" yaml:"total"`
+	Fetched   int    `json:"fetched" yaml:"fetched"`
+	Cancelled bool   `json:"cancelled" yaml:"cancelled"`
+	// Persisted is false when there is no disk cache, so the resources
+	// are only kept in memory until exit.
+	Persisted bool              `json:"persisted" yaml:"persisted"`
+	Failures  []prefetchFailure `json:"failures" yaml:"failures"`
+}
+
+func (r prefetchResult) printText(ui termui.Terminal) {
+	if r.Cancelled {
+		fmt.Printf("Prefetch cancelled after %v/%v\n", r.Fetched, r.Total)
+	}
+	if !r.Persisted {
+		fmt.Println("There is no disk cache, so resources are only kept until exit")
+	}
+	if len(r.Failures) < 1 {
+		fmt.Print(success(ui, fmt.Sprintf("Cached %v %s resources", r.Fetched, r.Endpoint)))
+		return
+	}
+	fmt.Println(failure(ui, fmt.Sprintf("%v of %v requests failed:", len(r.Failures), r.Fetched)))
+	for i, f := range r.Failures {
+		if i == prefetchErrorsToPrint {
+			fmt.Printf("- ...and %v more\n", len(r.Failures)-i)
+			break
+		}
+		fmt.Printf("- %s: %v\n", f.Path, f.Error)
+	}
+}
+
+func runPrefetch(args commandArgs, conf *config) (result, error) {
+	endpoint := normalizeID(strings.Trim(args.get("endpoint"), "/"))
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	paths, err := prefetchPaths(ctx, endpoint, args, conf)
+	if err != nil {
+		return nil, err
+	}
+	r := prefetchResult{
+		Endpoint:  endpoint,
+		Total:     len(paths),
+		Persisted: conf.pokeapiClient.Persistent(),
+		Failures:  []prefetchFailure{},
+	}
+	failed := warmAll(ctx, paths, conf, func(done int) {
+		r.Fetched = done
+		printStatus(conf, "\rPrefetching %s: %v/%v", endpoint, done, r.Total)
+	})
+	printStatus(conf, "\n")
+	r.Cancelled = ctx.Err() != nil
+	for _, f := range failed {
+		r.Failures = append(r.Failures, prefetchFailure{Path: f.path, Error: f.err.Error()})
+	}
+	return r, nil
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// settings is what the config file holds.
+type settings struct {
+	Aliases map[string]string `json:"aliases,omitempty"`
+	// loadErr is why the config file couldn't be loaded. Saving would
+	// overwrite whatever the user has in it, so it is refused.
+	loadErr error
+}
+
+// configDir follows the XDG base directory spec for user configuration.
+func configDir() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir != "" {
+		return filepath.Join(dir, "pokedexcli"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "pokedexcli"), nil
+}
+
+const cacheDirEnvVar = "POKEDEX_CACHE_DIR"
+
+// cacheDir is where API responses are kept between runs, overridden by
+// POKEDEX_CACHE_DIR. It is empty when no cache directory is known.
+func cacheDir() string {
+	if dir := os.Getenv(cacheDirEnvVar); dir != "" {
+		return dir
+	}
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "pokedexcli")
+}
+
+func configPath() (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "config.json"), nil
+}
+
+// loadSettings reads the config file. A missing file gives empty
+// settings, and so does a file that can't be read or parsed, but those
+// are then never saved over.
+func loadSettings() (settings, error) {
+	empty := settings{Aliases: map[string]string{}}
+	path, err := configPath()
+	if err != nil {
+		return empty, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return empty, nil
+	}
+	if err == nil {
+		s := settings{}
+		err = json.Unmarshal(data, &s)
+		if err == nil {
+			if s.Aliases == nil {
+				s.Aliases = map[string]string{}
+			}
+			return s, nil
+		}
+	}
+	empty.loadErr = err
+	return empty, err
+}
+
+// newSettings loads the config file. Without one, settings only last
+// for this session.
+func newSettings() settings {
+	s, err := loadSettings()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Could not load config: %v\n", err)
+	}
+	return s
+}
+
+func saveSettings(s settings) error {
+	path, err := configPath()
+	if err != nil {
+		return err
+	}
+	if s.loadErr != nil {
+		return fmt.Errorf("not saving over %s, fix it first: %v", path, s.loadErr)
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCacheDir(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(cacheDirEnvVar, dir)
+	if actual := cacheDir(); actual != dir {
+		t.Errorf("expected %s, got %s", dir, actual)
+		return
+	}
+	t.Setenv(cacheDirEnvVar, "")
+	t.Setenv("XDG_CACHE_HOME", dir)
+	expected := filepath.Join(dir, "pokedexcli")
+	if actual := cacheDir(); actual != expected {
+		t.Errorf("expected %s, got %s", expected, actual)
+		return
+	}
+}
+
+func TestBrokenSettingsAreNotSaved(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	path := filepath.Join(dir, "pokedexcli", "config.json")
+	broken := []byte(`{"aliases": {"cp": "catch pikachu",}}`)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, broken, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := loadSettings()
+	if err == nil || len(s.Aliases) != 0 {
+		t.Errorf("expected an error and no aliases, got %v and %v", err, s.Aliases)
+		return
+	}
+	s.Aliases["c"] = "catch"
+	if err := saveSettings(s); err == nil {
+		t.Errorf("expected saving to be refused")
+		return
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != string(broken) {
+		t.Errorf("expected the config file to be left alone, got %q (%v)", data, err)
+		return
+	}
+}
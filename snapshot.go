@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dudiko2/pokedexcli/internal/termui"
+)
+
+type snapshotResult struct {
+	Action    string `json:"action" yaml:"action"`
+	File      string `json:"file" yaml:"file"`
+	Resources int    `json:"resources" yaml:"resources"`
+}
+
+func (r snapshotResult) printText(ui termui.Terminal) {
+	if r.Action == "export" {
+		fmt.Printf("Exported %v resources to %s", r.Resources, r.File)
+		return
+	}
+	fmt.Printf("Imported %v resources from %s", r.Resources, r.File)
+}
+
+func runSnapshotExport(args commandArgs, conf *config) (result, error) {
+	file := args.get("file")
+	f, err := os.Create(file)
+	if err != nil {
+		return nil, err
+	}
+	n, err := conf.pokeapiClient.ExportSnapshot(f)
+	closeErr := f.Close()
+	if err != nil {
+		os.Remove(file)
+		return nil, err
+	}
+	if closeErr != nil {
+		return nil, closeErr
+	}
+	return snapshotResult{Action: "export", File: file, Resources: n}, nil
+}
+
+func runSnapshotImport(args commandArgs, conf *config) (result, error) {
+	file := args.get("file")
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	n, err := conf.pokeapiClient.ImportSnapshot(f)
+	if err != nil {
+		return nil, err
+	}
+	return snapshotResult{Action: "import", File: file, Resources: n}, nil
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/png"
+	"strconv"
+
+	"github.com/dudiko2/pokedexcli/internal/pokeapi"
+	"github.com/dudiko2/pokedexcli/internal/termui"
+)
+
+const maxGeneration = 8
+
+// spriteSet holds the sprite URLs of one game. Empty URLs are sprites
+// the game doesn't have.
+type spriteSet struct {
+	front, back, frontShiny, backShiny string
+}
+
+func (s spriteSet) url(shiny, back bool) string {
+	switch {
+	case shiny && back:
+		return s.backShiny
+	case shiny:
+		return s.frontShiny
+	case back:
+		return s.back
+	}
+	return s.front
+}
+
+// generationSprites picks one game per generation. Generation 0 is the
+// default sprites.
+func generationSprites(p pokeapi.PokemonRes, gen int) spriteSet {
+	s := p.Sprites
+	v := p.Sprites.Versions
+	switch gen {
+	case 1:
+		return spriteSet{front: v.GenerationI.RedBlue.FrontDefault, back: v.GenerationI.RedBlue.BackDefault}
+	case 2:
+		c := v.GenerationIi.Crystal
+		return spriteSet{c.FrontDefault, c.BackDefault, c.FrontShiny, c.BackShiny}
+	case 3:
+		f := v.GenerationIii.FireredLeafgreen
+		return spriteSet{f.FrontDefault, f.BackDefault, f.FrontShiny, f.BackShiny}
+	case 4:
+		pl := v.GenerationIv.Platinum
+		return spriteSet{pl.FrontDefault, pl.BackDefault, pl.FrontShiny, pl.BackShiny}
+	case 5:
+		b := v.GenerationV.BlackWhite
+		return spriteSet{b.FrontDefault, b.BackDefault, b.FrontShiny, b.BackShiny}
+	case 6:
+		xy := v.GenerationVi.XY
+		return spriteSet{front: xy.FrontDefault, frontShiny: xy.FrontShiny}
+	case 7:
+		u := v.GenerationVii.UltraSunUltraMoon
+		return spriteSet{front: u.FrontDefault, frontShiny: u.FrontShiny}
+	case 8:
+		return spriteSet{front: v.GenerationViii.Icons.FrontDefault}
+	}
+	return spriteSet{s.FrontDefault, s.BackDefault, s.FrontShiny, s.BackShiny}
+}
+
+func parseGeneration(s string) (int, error) {
+	gen, err := strconv.Atoi(s)
+	if err != nil || gen < 1 || gen > maxGeneration {
+		return 0, fmt.Errorf("invalid generation: %s, expected 1-%v", s, maxGeneration)
+	}
+	return gen, nil
+}
+
+func describeSprite(shiny, back bool) string {
+	kind := "front"
+	if back {
+		kind = "back"
+	}
+	if shiny {
+		kind = "shiny " + kind
+	}
+	return kind
+}
+
+func spriteURL(p pokeapi.PokemonRes, gen int, shiny, back bool) (string, error) {
+	url := generationSprites(p, gen).url(shiny, back)
+	if url != "" {
+		return url, nil
+	}
+	if gen == 0 {
+		return "", fmt.Errorf("%s has no %s sprite", p.Name, describeSprite(shiny, back))
+	}
+	return "", fmt.Errorf("%s has no %s sprite in generation %v", p.Name, describeSprite(shiny, back), gen)
+}
+
+func loadSprite(url string, conf *config) (image.Image, error) {
+	data, err := conf.pokeapiClient.GetSprite(url)
+	if err != nil {
+		return nil, err
+	}
+	return png.Decode(bytes.NewReader(data))
+}
+
+type spriteResult struct {
+	Pokemon label  `json:"pokemon" yaml:"pokemon"`
+	URL     string `json:"url" yaml:"url"`
+	image   image.Image
+}
+
+func (r spriteResult) printText(ui termui.Terminal) {
+	if r.image == nil {
+		fmt.Println(r.URL)
+		return
+	}
+	fmt.Print(ui.Image(r.image))
+}
+
+func runSprite(args commandArgs, conf *config) (result, error) {
+	gen := 0
+	if value, ok := args.flag("gen"); ok {
+		var err error
+		gen, err = parseGeneration(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pokemon, err := lookup("pokemon", args.get("pokemon"), conf, conf.pokeapiClient.GetPokemonData)
+	if err != nil {
+		return nil, err
+	}
+	url, err := spriteURL(pokemon, gen, args.has("shiny"), args.has("back"))
+	if err != nil {
+		return nil, err
+	}
+	r := spriteResult{Pokemon: pokemonLabel(pokemon.Name, conf), URL: url}
+	// encoded output only needs the URL
+	if conf.output == outputText {
+		r.image, err = loadSprite(url, conf)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/dudiko2/pokedexcli/internal/pokeapi"
+	"github.com/dudiko2/pokedexcli/internal/termui"
+)
+
+func randomNature(conf *config) (pokeapi.NatureRes, error) {
+	natures, err := pokeapi.ListAll[pokeapi.NameURLPair](context.Background(), &conf.pokeapiClient, "nature")
+	if err != nil {
+		return pokeapi.NatureRes{}, err
+	}
+	if len(natures) < 1 {
+		return pokeapi.NatureRes{}, errors.New("no natures available")
+	}
+	pick := natures[rand.Intn(len(natures))]
+	return conf.pokeapiClient.GetNature(pick.Name)
+}
+
+// natureModifier returns the multiplier a nature applies to a stat.
+// Neutral natures raise and lower the same stat, which cancels out.
+func natureModifier(n pokeapi.NatureRes, stat string) float64 {
+	mod := 1.0
+	if n.IncreasedStat != nil && n.IncreasedStat.Name == stat {
+		mod += 0.1
+	}
+	if n.DecreasedStat != nil && n.DecreasedStat.Name == stat {
+		mod -= 0.1
+	}
+	return mod
+}
+
+func isNeutralNature(n pokeapi.NatureRes) bool {
+	if n.IncreasedStat == nil || n.DecreasedStat == nil {
+		return true
+	}
+	return n.IncreasedStat.Name == n.DecreasedStat.Name
+}
+
+// natureEffect is "+" or "-" if the nature raises or lowers stat.
+func natureEffect(n pokeapi.NatureRes, stat string) string {
+	mod := natureModifier(n, stat)
+	if mod > 1 {
+		return "+"
+	}
+	if mod < 1 {
+		return "-"
+	}
+	return ""
+}
+
+// calculateStat applies the main series stat formula, assuming no IVs
+// or EVs.
+func calculateStat(stat string, base, level int, n pokeapi.NatureRes) int {
+	scaled := 2 * base * level / 100
+	if stat == "hp" {
+		return scaled + level + 10
+	}
+	return int(float64(scaled+5) * natureModifier(n, stat))
+}
+
+type natureResult struct {
+	Name   label  `json:"name" yaml:"name"`
+	Raises *label `json:"raises,omitempty" yaml:"raises,omitempty"`
+	Lowers *label `json:"lowers,omitempty" yaml:"lowers,omitempty"`
+}
+
+func (n natureResult) String() string {
+	if n.Name.Name == "" {
+		return "unknown"
+	}
+	if n.Raises == nil || n.Lowers == nil {
+		return n.Name.String() + " (neutral)"
+	}
+	return fmt.Sprintf("%s (+%s, -%s)", n.Name, n.Raises, n.Lowers)
+}
+
+func newNatureResult(n pokeapi.NatureRes, conf *config) natureResult {
+	r := natureResult{Name: textLabel(n.Names, n.Name, conf)}
+	if n.Name == "" || isNeutralNature(n) {
+		return r
+	}
+	raises := refLabel(*n.IncreasedStat, conf)
+	lowers := refLabel(*n.DecreasedStat, conf)
+	r.Raises = &raises
+	r.Lowers = &lowers
+	return r
+}
+
+type natureListResult struct {
+	Natures []natureResult `json:"natures" yaml:"natures"`
+}
+
+func (r natureListResult) printText(ui termui.Terminal) {
+	tab := termui.Table{Header: []string{"Nature", "Raises", "Lowers"}}
+	for _, n := range r.Natures {
+		raises, lowers := "-", "-"
+		if n.Raises != nil && n.Lowers != nil {
+			raises = success(ui, n.Raises.String())
+			lowers = failure(ui, n.Lowers.String())
+		}
+		tab.Rows = append(tab.Rows, []string{n.Name.String(), raises, lowers})
+	}
+	fmt.Print(ui.Render(tab))
+}
+
+func runNatures(args commandArgs, conf *config) (result, error) {
+	natures := pokeapi.List[pokeapi.NameURLPair](context.Background(), &conf.pokeapiClient, "nature")
+	r := natureListResult{Natures: []natureResult{}}
+	for natures.Next() {
+		n, err := conf.pokeapiClient.GetNature(natures.Item().Name)
+		if err != nil {
+			return nil, err
+		}
+		r.Natures = append(r.Natures, newNatureResult(n, conf))
+	}
+	return r, natures.Err()
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dudiko2/pokedexcli/internal/pokeapi"
+)
+
+func testNature(name, raises, lowers string) pokeapi.NatureRes {
+	return pokeapi.NatureRes{
+		Name:          name,
+		IncreasedStat: &pokeapi.NameURLPair{Name: raises},
+		DecreasedStat: &pokeapi.NameURLPair{Name: lowers},
+	}
+}
+
+func TestNatureModifier(t *testing.T) {
+	cases := []struct {
+		nature   pokeapi.NatureRes
+		stat     string
+		expected float64
+	}{
+		{nature: testNature("adamant", "attack", "special-attack"), stat: "attack", expected: 1.1},
+		{nature: testNature("adamant", "attack", "special-attack"), stat: "special-attack", expected: 0.9},
+		{nature: testNature("adamant", "attack", "special-attack"), stat: "speed", expected: 1},
+		{nature: testNature("hardy", "attack", "attack"), stat: "attack", expected: 1},
+		{nature: pokeapi.NatureRes{Name: "unknown"}, stat: "attack", expected: 1},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			actual := natureModifier(c.nature, c.stat)
+			if fmt.Sprintf("%.2f", actual) != fmt.Sprintf("%.2f", c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, actual)
+				return
+			}
+		})
+	}
+}
+
+func TestCalculateStat(t *testing.T) {
+	adamant := testNature("adamant", "attack", "special-attack")
+	hardy := testNature("hardy", "attack", "attack")
+	cases := []struct {
+		stat     string
+		base     int
+		level    int
+		nature   pokeapi.NatureRes
+		expected int
+	}{
+		{stat: "hp", base: 35, level: 50, nature: adamant, expected: 95},
+		{stat: "hp", base: 35, level: 100, nature: hardy, expected: 180},
+		{stat: "attack", base: 55, level: 50, nature: hardy, expected: 60},
+		{stat: "attack", base: 55, level: 50, nature: adamant, expected: 66},
+		{stat: "special-attack", base: 50, level: 50, nature: adamant, expected: 49},
+		{stat: "speed", base: 90, level: 100, nature: adamant, expected: 185},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			actual := calculateStat(c.stat, c.base, c.level, c.nature)
+			if actual != c.expected {
+				t.Errorf("expected %v, got %v", c.expected, actual)
+				return
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/dudiko2/pokedexcli/internal/termui"
+)
+
+const (
+	statBarWidth = 20
+	maxBaseStat  = 255
+)
+
+// typeColors roughly follows the colors the games use for each type.
+var typeColors = map[string]termui.Color{
+	"normal":   termui.Color256(250),
+	"fire":     termui.Color256(202),
+	"water":    termui.Color256(33),
+	"grass":    termui.Color256(34),
+	"electric": termui.Color256(220),
+	"ice":      termui.Color256(87),
+	"fighting": termui.Color256(124),
+	"poison":   termui.Color256(128),
+	"ground":   termui.Color256(178),
+	"flying":   termui.Color256(111),
+	"psychic":  termui.Color256(205),
+	"bug":      termui.Color256(106),
+	"rock":     termui.Color256(136),
+	"ghost":    termui.Color256(61),
+	"dragon":   termui.Color256(57),
+	"dark":     termui.Color256(94),
+	"steel":    termui.Color256(146),
+	"fairy":    termui.Color256(218),
+}
+
+func paintTypes(ui termui.Terminal, types []label) string {
+	list := make([]string, 0, len(types))
+	for _, t := range types {
+		list = append(list, ui.Paint(t.String(), termui.Bold, typeColors[t.Name]))
+	}
+	return strings.Join(list, ", ")
+}
+
+func success(ui termui.Terminal, s string) string {
+	return ui.Paint(s, termui.Green)
+}
+
+func failure(ui termui.Terminal, s string) string {
+	return ui.Paint(s, termui.Red)
+}
+
+func heading(ui termui.Terminal, s string) string {
+	return ui.Paint(s, termui.Bold)
+}
+
+// statColor grades a base stat: low in red, average in yellow, high in
+// green.
+func statColor(base int) termui.Color {
+	switch {
+	case base < 60:
+		return termui.Red
+	case base < 90:
+		return termui.Yellow
+	}
+	return termui.Green
+}
+
+var pokedexStatusColors = map[string]termui.Color{
+	"caught":  termui.Green,
+	"seen":    termui.Yellow,
+	"missing": termui.Dim,
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/dudiko2/pokedexcli/internal/fuzzy"
+	"github.com/dudiko2/pokedexcli/internal/pokeapi"
+)
+
+const suggestionLimit = 3
+
+// knownNames returns every resource name of endpoint. The list is
+// fetched once per session through the list endpoint, and from then on
+// comes from the client's caches.
+func knownNames(endpoint string, conf *config) ([]string, error) {
+	names, ok := conf.nameIndex[endpoint]
+	if ok {
+		return names, nil
+	}
+	ctx := context.Background()
+	refs, err := pokeapi.ListAll[pokeapi.NameURLPair](ctx, &conf.pokeapiClient, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	names = make([]string, 0, len(refs))
+	for _, r := range refs {
+		names = append(names, r.Name)
+	}
+	conf.nameIndex[endpoint] = names
+	return names, nil
+}
+
+// matchName picks the candidate id was meant to be: an exact match or
+// an unambiguous prefix.
+func matchName(id string, candidates []string) (string, bool) {
+	id = normalizeID(id)
+	if slices.Contains(candidates, id) {
+		return id, true
+	}
+	return fuzzy.UniquePrefix(id, candidates)
+}
+
+// didYouMean formats the candidates closest to id as a hint to append
+// to an error, or returns "" if nothing is close.
+func didYouMean(id string, candidates []string) string {
+	suggestions := fuzzy.Suggest(id, candidates, suggestionLimit)
+	if len(suggestions) < 1 {
+		return ""
+	}
+	return fmt.Sprintf(", did you mean %s?", strings.Join(suggestions, " or "))
+}
+
+// lookup calls get with the canonical form of id, and if the API doesn't
+// know it, retries with the name it most likely stands for. Resources
+// should then be referred to by the name in the response.
+func lookup[T any](endpoint, id string, conf *config, get func(string) (T, error)) (T, error) {
+	res, err := get(normalizeID(id))
+	if !errors.Is(err, pokeapi.ErrNotFound) || isNumericID(normalizeID(id)) {
+		return res, err
+	}
+	names, indexErr := knownNames(endpoint, conf)
+	if indexErr != nil {
+		return res, err
+	}
+	match, ok := matchName(id, names)
+	if !ok {
+		return res, fmt.Errorf("%s not found%s", id, didYouMean(id, names))
+	}
+	return get(match)
+}
+
+// findCaught is lookup for the caught Pokemon store.
+func findCaught(name string) (ownedPokemon, error) {
+	key, caught := caughtKey(name)
+	if caught {
+		return caughtPokemon[key], nil
+	}
+	names := make([]string, 0, len(caughtPokemon))
+	for n := range caughtPokemon {
+		names = append(names, n)
+	}
+	match, ok := matchName(name, names)
+	if !ok {
+		return ownedPokemon{}, fmt.Errorf("you have not caught %s%s", name, didYouMean(name, names))
+	}
+	return caughtPokemon[match], nil
+}
@@ -0,0 +1,468 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/dudiko2/pokedexcli/internal/lineedit"
+	"github.com/dudiko2/pokedexcli/internal/termui"
+	"golang.org/x/term"
+)
+
+const (
+	tuiViewMap = iota
+	tuiViewPokedex
+)
+
+const (
+	tuiFocusAreas = iota
+	tuiFocusEncounters
+)
+
+const tuiMaxListWidth = 32
+
+// control keys, as read in raw mode
+const (
+	tuiKeyCtrlC = 3
+	tuiKeyCtrlD = 4
+	tuiKeyEnter = 13
+)
+
+const tuiKeys = "tab view  ↑↓ move  ←→ pane  enter/e explore  c catch  n/b page  q quit"
+
+// tuiList is a scrollable list with a cursor.
+type tuiList struct {
+	items  []label
+	cursor int
+	offset int
+}
+
+func (l *tuiList) set(items []label) {
+	l.items = items
+	l.cursor = 0
+	l.offset = 0
+}
+
+func (l *tuiList) move(n int) {
+	if len(l.items) < 1 {
+		return
+	}
+	l.cursor = max(0, min(len(l.items)-1, l.cursor+n))
+}
+
+func (l *tuiList) selected() (label, bool) {
+	if len(l.items) < 1 {
+		return label{}, false
+	}
+	return l.items[l.cursor], true
+}
+
+// lines draws the items that fit in height rows, scrolling to keep the
+// cursor in view. The cursor is highlighted when the list has focus.
+func (l *tuiList) lines(ui termui.Terminal, height int, focused bool, mark func(label) string) []string {
+	if height < 1 {
+		return nil
+	}
+	if l.cursor < l.offset {
+		l.offset = l.cursor
+	}
+	if l.cursor >= l.offset+height {
+		l.offset = l.cursor - height + 1
+	}
+	lines := []string{}
+	for i := l.offset; i < len(l.items) && i < l.offset+height; i++ {
+		line := "  " + l.items[i].String()
+		if mark != nil {
+			line += mark(l.items[i])
+		}
+		if i == l.cursor {
+			line = "> " + strings.TrimPrefix(line, "  ")
+			if focused {
+				line = ui.Paint(termui.Strip(line), termui.Reverse)
+			}
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// tuiModel is the state of the full-screen interface. Every action goes
+// through the same commands the shell runs; the results are drawn in
+// panes instead of printed.
+type tuiModel struct {
+	conf       *config
+	view       int
+	focus      int
+	areas      tuiList
+	encounters tuiList
+	explored   label
+	caught     tuiList
+	preview    *pokemonResult
+	// previews caches inspect results by Pokemon until the Pokedex is
+	// reloaded, so scrolling back doesn't fetch sprites again.
+	previews       map[string]*pokemonResult
+	previewPending bool
+	status         string
+	failed         bool
+}
+
+func (m *tuiModel) call(words ...string) (result, error) {
+	cmd, ok := m.conf.commands[words[0]]
+	if !ok {
+		return nil, unknownCommandError(words[0], m.conf)
+	}
+	return callCommand(cmd, words[1:], m.conf)
+}
+
+func (m *tuiModel) setStatus(s string) {
+	m.status = s
+	m.failed = false
+}
+
+func (m *tuiModel) setError(err error) {
+	m.status = err.Error()
+	m.failed = true
+}
+
+// loadAreas runs map or mapb, which page through GetLocationAreas.
+func (m *tuiModel) loadAreas(command string) {
+	res, err := m.call(command)
+	if err != nil {
+		m.setError(err)
+		return
+	}
+	if r, ok := res.(areaListResult); ok {
+		m.areas.set(r.Areas)
+		m.focus = tuiFocusAreas
+	}
+}
+
+func (m *tuiModel) explore() {
+	area, ok := m.areas.selected()
+	if !ok {
+		return
+	}
+	res, err := m.call("explore", area.Name)
+	if err != nil {
+		m.setError(err)
+		return
+	}
+	r, ok := res.(exploreResult)
+	if !ok || len(r.Areas) < 1 {
+		return
+	}
+	m.explored = r.Areas[0].Area
+	m.encounters.set(r.Areas[0].Pokemon)
+	m.setStatus(fmt.Sprintf("Found %v Pokemon in %s", len(m.encounters.items), m.explored))
+	if len(m.encounters.items) > 0 {
+		m.focus = tuiFocusEncounters
+	}
+}
+
+func (m *tuiModel) catch() {
+	pokemon, ok := m.encounters.selected()
+	if !ok {
+		return
+	}
+	res, err := m.call("catch", pokemon.Name)
+	if err != nil {
+		m.setError(err)
+		return
+	}
+	r, ok := res.(catchResult)
+	if !ok {
+		return
+	}
+	if !r.Caught {
+		m.status = fmt.Sprintf("%s escaped!", r.Pokemon)
+		m.failed = true
+		return
+	}
+	m.setStatus(fmt.Sprintf("%s was caught at level %v!", r.Pokemon, r.Level))
+}
+
+func (m *tuiModel) loadPokedex() {
+	res, err := m.call("pokedex")
+	if err != nil {
+		m.setError(err)
+		return
+	}
+	if r, ok := res.(caughtListResult); ok {
+		cursor := m.caught.cursor
+		m.caught.set(r.Pokemon)
+		m.caught.move(cursor)
+	}
+	// levels change as Pokemon are caught
+	m.previews = map[string]*pokemonResult{}
+	m.loadPreview()
+}
+
+// loadPreview inspects the selected Pokemon. Without a sprite, the
+// details are still shown.
+func (m *tuiModel) loadPreview() {
+	m.preview = nil
+	m.previewPending = false
+	pokemon, ok := m.caught.selected()
+	if !ok {
+		return
+	}
+	if p, ok := m.previews[pokemon.Name]; ok {
+		m.preview = p
+		return
+	}
+	res, err := m.call("inspect", pokemon.Name, "--sprite")
+	if err != nil {
+		m.setError(err)
+		res, err = m.call("inspect", pokemon.Name)
+	}
+	if err != nil {
+		return
+	}
+	if r, ok := res.(pokemonResult); ok {
+		m.preview = &r
+		if m.previews != nil {
+			m.previews[pokemon.Name] = &r
+		}
+	}
+}
+
+// handleKey applies one key press and reports whether to quit.
+func (m *tuiModel) handleKey(key rune) bool {
+	switch key {
+	case 'q', tuiKeyCtrlC, tuiKeyCtrlD:
+		return true
+	case '\t':
+		m.switchView(1 - m.view)
+		return false
+	case '1':
+		m.switchView(tuiViewMap)
+		return false
+	case '2':
+		m.switchView(tuiViewPokedex)
+		return false
+	}
+	if m.view == tuiViewPokedex {
+		m.handlePokedexKey(key)
+	} else {
+		m.handleMapKey(key)
+	}
+	return false
+}
+
+func (m *tuiModel) switchView(view int) {
+	m.view = view
+	if view == tuiViewPokedex {
+		m.loadPokedex()
+	}
+}
+
+func (m *tuiModel) handleMapKey(key rune) {
+	list := &m.areas
+	if m.focus == tuiFocusEncounters {
+		list = &m.encounters
+	}
+	switch key {
+	case lineedit.KeyUp, 'k':
+		list.move(-1)
+	case lineedit.KeyDown, 'j':
+		list.move(1)
+	case lineedit.KeyPageUp:
+		list.move(-10)
+	case lineedit.KeyPageDown:
+		list.move(10)
+	case lineedit.KeyLeft, 'h':
+		m.focus = tuiFocusAreas
+	case lineedit.KeyRight, 'l':
+		if len(m.encounters.items) > 0 {
+			m.focus = tuiFocusEncounters
+		}
+	case tuiKeyEnter, '\n', 'e':
+		if m.focus == tuiFocusAreas {
+			m.explore()
+		}
+	case 'c':
+		if m.focus == tuiFocusEncounters {
+			m.catch()
+		}
+	case 'n':
+		m.loadAreas("map")
+	case 'b':
+		m.loadAreas("mapb")
+	}
+}
+
+func (m *tuiModel) handlePokedexKey(key rune) {
+	cursor := m.caught.cursor
+	switch key {
+	case lineedit.KeyUp, 'k':
+		m.caught.move(-1)
+	case lineedit.KeyDown, 'j':
+		m.caught.move(1)
+	case lineedit.KeyPageUp:
+		m.caught.move(-10)
+	case lineedit.KeyPageDown:
+		m.caught.move(10)
+	}
+	// the preview is loaded by the main loop once the keys stop coming
+	if m.caught.cursor != cursor {
+		m.preview = nil
+		m.previewPending = true
+	}
+}
+
+// pokemonMark tags Pokemon in the encounter list that are caught.
+func (m *tuiModel) pokemonMark(p label) string {
+	if _, caught := caughtPokemon[p.Name]; caught {
+		return " " + m.conf.ui.Paint("(caught)", pokedexStatusColors["caught"])
+	}
+	return ""
+}
+
+func (m *tuiModel) mapPanes(height int) ([]string, []string) {
+	ui := m.conf.ui
+	left := []string{heading(ui, "Location areas")}
+	left = append(left, m.areas.lines(ui, height-1, m.focus == tuiFocusAreas, nil)...)
+	if m.explored.Name == "" {
+		return left, []string{ui.Paint("Select an area and press enter to explore it", termui.Dim)}
+	}
+	right := []string{heading(ui, m.explored.String())}
+	if len(m.encounters.items) < 1 {
+		right = append(right, "No Pokemon found!")
+	}
+	right = append(right, m.encounters.lines(ui, height-1, m.focus == tuiFocusEncounters, m.pokemonMark)...)
+	return left, right
+}
+
+func (m *tuiModel) pokedexPanes(height, rightWidth int) ([]string, []string) {
+	ui := m.conf.ui
+	left := []string{heading(ui, fmt.Sprintf("Caught Pokemon (%v)", len(m.caught.items)))}
+	left = append(left, m.caught.lines(ui, height-1, true, nil)...)
+	if m.previewPending {
+		return left, []string{ui.Paint("Loading...", termui.Dim)}
+	}
+	if m.preview == nil {
+		return left, []string{ui.Paint("Catch Pokemon in the map view to fill your Pokedex", termui.Dim)}
+	}
+	p := m.preview
+	right := []string{}
+	if p.sprite != nil {
+		spriteUI := ui
+		spriteUI.Columns = func() int {
+			return rightWidth
+		}
+		right = append(right, strings.Split(strings.TrimSuffix(spriteUI.Image(p.sprite), "\n"), "\n")...)
+	}
+	right = append(right, heading(ui, p.Name.String()))
+	if len(p.Types) > 0 {
+		right = append(right, "Types: "+paintTypes(ui, p.Types))
+	}
+	right = append(right, fmt.Sprintf("Level %v, %s", p.Level, p.Nature))
+	for _, s := range p.Stats {
+		bar := ui.Bar(s.BaseStat, maxBaseStat, statBarWidth/2, statColor(s.BaseStat))
+		right = append(right, fmt.Sprintf("%s %s %v", termui.PadRight(s.Stat.String(), 16), bar, s.Value))
+	}
+	return left, right
+}
+
+// pad fits s into exactly width columns.
+func pad(s string, width int) string {
+	return termui.PadRight(termui.Truncate(s, width), width)
+}
+
+func (m *tuiModel) draw(w io.Writer, width, height int) {
+	ui := m.conf.ui
+	bodyHeight := max(1, height-3)
+	leftWidth := min(tuiMaxListWidth, width/3)
+	rightWidth := max(1, width-leftWidth-1)
+
+	tabs := []string{}
+	for i, name := range []string{"1 Map", "2 Pokedex"} {
+		if i == m.view {
+			name = ui.Paint(" "+name+" ", termui.Reverse)
+		} else {
+			name = " " + name + " "
+		}
+		tabs = append(tabs, name)
+	}
+	lines := []string{heading(ui, "Pokedex") + "  " + strings.Join(tabs, " ")}
+
+	var left, right []string
+	if m.view == tuiViewPokedex {
+		left, right = m.pokedexPanes(bodyHeight, rightWidth)
+	} else {
+		left, right = m.mapPanes(bodyHeight)
+	}
+	for i := range bodyHeight {
+		l, r := "", ""
+		if i < len(left) {
+			l = left[i]
+		}
+		if i < len(right) {
+			r = right[i]
+		}
+		lines = append(lines, pad(l, leftWidth)+ui.Paint("│", termui.Dim)+pad(r, rightWidth))
+	}
+	status := m.status
+	if m.failed {
+		status = failure(ui, status)
+	}
+	lines = append(lines, status, ui.Paint(tuiKeys, termui.Dim))
+
+	var sb strings.Builder
+	for i, line := range lines {
+		fmt.Fprintf(&sb, "\x1b[%d;1H%s\x1b[K", i+1, pad(line, width))
+	}
+	fmt.Fprint(w, sb.String())
+}
+
+// runTUI runs the full-screen interface until the user quits.
+func runTUI(conf *config) int {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) || !term.IsTerminal(int(os.Stdout.Fd())) {
+		fmt.Fprintln(os.Stderr, "Error: tui needs a terminal")
+		return exitUsage
+	}
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return exitFailure
+	}
+	defer term.Restore(fd, oldState)
+	// alternate screen, cursor hidden
+	fmt.Print("\x1b[?1049h\x1b[?25l")
+	defer fmt.Print("\x1b[?25h\x1b[?1049l")
+
+	editor := lineedit.New(os.Stdin, os.Stdout)
+	m := tuiModel{conf: conf}
+	redraw := func() {
+		width, height := conf.ui.Width(), 24
+		if _, h, err := term.GetSize(int(os.Stdout.Fd())); err == nil {
+			height = h
+		}
+		m.draw(os.Stdout, max(width, 20), max(height, 5))
+	}
+	m.setStatus("Loading...")
+	redraw()
+	m.setStatus("")
+	m.loadAreas("map")
+	for {
+		if m.previewPending && !editor.Pending() {
+			redraw()
+			m.loadPreview()
+		}
+		redraw()
+		key, err := editor.ReadKey()
+		if errors.Is(err, io.EOF) {
+			return exitOK
+		}
+		if err != nil {
+			return exitFailure
+		}
+		if m.handleKey(key) {
+			return exitOK
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/dudiko2/pokedexcli/internal/lineedit"
+	"github.com/dudiko2/pokedexcli/internal/termui"
+)
+
+func testLabels(names ...string) []label {
+	list := []label{}
+	for _, n := range names {
+		list = append(list, label{Name: n})
+	}
+	return list
+}
+
+func TestTuiListMove(t *testing.T) {
+	cases := []struct {
+		items    int
+		moves    []int
+		expected int
+	}{
+		{items: 3, moves: []int{1}, expected: 1},
+		{items: 3, moves: []int{1, 1, 1, 1}, expected: 2},
+		{items: 3, moves: []int{-1}, expected: 0},
+		{items: 30, moves: []int{10, 10, -5}, expected: 15},
+		{items: 30, moves: []int{100}, expected: 29},
+		{items: 0, moves: []int{1}, expected: 0},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			l := tuiList{}
+			for n := range c.items {
+				l.items = append(l.items, label{Name: fmt.Sprint(n)})
+			}
+			for _, m := range c.moves {
+				l.move(m)
+			}
+			if l.cursor != c.expected {
+				t.Errorf("expected cursor %v, got %v", c.expected, l.cursor)
+				return
+			}
+		})
+	}
+}
+
+func TestTuiListLines(t *testing.T) {
+	cases := []struct {
+		cursor   int
+		offset   int
+		height   int
+		expected []string
+		offsetTo int
+	}{
+		{cursor: 0, offset: 0, height: 3, expected: []string{"> a", "  b", "  c"}, offsetTo: 0},
+		{cursor: 3, offset: 0, height: 2, expected: []string{"  c", "> d"}, offsetTo: 2},
+		{cursor: 1, offset: 3, height: 2, expected: []string{"> b", "  c"}, offsetTo: 1},
+		{cursor: 4, offset: 0, height: 10, expected: []string{"  a", "  b", "  c", "  d", "> e"}, offsetTo: 0},
+		{cursor: 0, offset: 0, height: 0, expected: nil, offsetTo: 0},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			l := tuiList{items: testLabels("a", "b", "c", "d", "e"), cursor: c.cursor, offset: c.offset}
+			actual := l.lines(termui.Terminal{}, c.height, true, nil)
+			if !slices.Equal(actual, c.expected) {
+				t.Errorf("expected %q, got %q", c.expected, actual)
+				return
+			}
+			if l.offset != c.offsetTo {
+				t.Errorf("expected offset %v, got %v", c.offsetTo, l.offset)
+				return
+			}
+		})
+	}
+}
+
+func TestTuiHandleKey(t *testing.T) {
+	saved := caughtPokemon
+	t.Cleanup(func() {
+		caughtPokemon = saved
+	})
+	caughtPokemon = map[string]ownedPokemon{}
+	cases := []struct {
+		view       int
+		focus      int
+		encounters []label
+		key        rune
+		quit       bool
+		toView     int
+		toFocus    int
+	}{
+		{view: tuiViewMap, key: 'q', quit: true},
+		{view: tuiViewPokedex, key: tuiKeyCtrlC, quit: true, toView: tuiViewPokedex},
+		{view: tuiViewMap, key: '\t', toView: tuiViewPokedex},
+		{view: tuiViewPokedex, key: '\t', toView: tuiViewMap},
+		{view: tuiViewMap, key: '2', toView: tuiViewPokedex},
+		{view: tuiViewPokedex, key: '1', toView: tuiViewMap},
+		{view: tuiViewMap, key: lineedit.KeyRight, toFocus: tuiFocusAreas},
+		{view: tuiViewMap, encounters: testLabels("pikachu"), key: lineedit.KeyRight, toFocus: tuiFocusEncounters},
+		{view: tuiViewMap, encounters: testLabels("pikachu"), key: 'l', toFocus: tuiFocusEncounters},
+		{view: tuiViewMap, focus: tuiFocusEncounters, encounters: testLabels("pikachu"), key: lineedit.KeyLeft, toFocus: tuiFocusAreas},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			m := tuiModel{
+				conf: &config{
+					commands: newCommands(),
+					settings: settings{Aliases: map[string]string{}},
+					output:   outputText,
+				},
+				view:  c.view,
+				focus: c.focus,
+			}
+			m.encounters.set(c.encounters)
+			quit := m.handleKey(c.key)
+			if quit != c.quit {
+				t.Errorf("expected quit %v, got %v", c.quit, quit)
+				return
+			}
+			if quit {
+				return
+			}
+			if m.view != c.toView || m.focus != c.toFocus {
+				t.Errorf("expected view %v focus %v, got view %v focus %v", c.toView, c.toFocus, m.view, m.focus)
+				return
+			}
+		})
+	}
+}
+
+func TestPad(t *testing.T) {
+	cases := []struct {
+		input    string
+		width    int
+		expected string
+	}{
+		{input: "hp", width: 4, expected: "hp  "},
+		{input: "pikachu", width: 4, expected: "pik…"},
+		{input: "pikachu", width: 7, expected: "pikachu"},
+		{input: "ピカチュウ", width: 6, expected: "ピカ… "},
+		{input: "", width: 2, expected: "  "},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			actual := pad(c.input, c.width)
+			if actual != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, actual)
+				return
+			}
+		})
+	}
+}
+
+func TestTuiPreview(t *testing.T) {
+	saved := caughtPokemon
+	t.Cleanup(func() {
+		caughtPokemon = saved
+	})
+	// nothing is caught, so only cached previews can be shown
+	caughtPokemon = map[string]ownedPokemon{}
+	cases := []struct {
+		cached   []string
+		key      rune
+		pending  bool
+		expected string
+	}{
+		{cached: []string{"bulbasaur", "pikachu"}, key: 'j', pending: true, expected: "pikachu"},
+		{cached: []string{"bulbasaur"}, key: 'j', pending: true, expected: ""},
+		{cached: []string{"bulbasaur", "pikachu"}, key: 'k', pending: false, expected: ""},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			m := tuiModel{
+				conf: &config{
+					commands: newCommands(),
+					settings: settings{Aliases: map[string]string{}},
+					output:   outputText,
+				},
+				view:     tuiViewPokedex,
+				previews: map[string]*pokemonResult{},
+			}
+			m.caught.set(testLabels("bulbasaur", "pikachu"))
+			for _, name := range c.cached {
+				m.previews[name] = &pokemonResult{Name: label{Name: name}}
+			}
+			m.handleKey(c.key)
+			if m.previewPending != c.pending {
+				t.Errorf("expected pending %v, got %v", c.pending, m.previewPending)
+				return
+			}
+			if !m.previewPending {
+				return
+			}
+			m.loadPreview()
+			actual := ""
+			if m.preview != nil {
+				actual = m.preview.Name.Name
+			}
+			if actual != c.expected {
+				t.Errorf("expected preview %q, got %q", c.expected, actual)
+				return
+			}
+		})
+	}
+}